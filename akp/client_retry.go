@@ -0,0 +1,467 @@
+package akp
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/akuity/terraform-provider-akp/akp/waiter"
+)
+
+// defaultMaxRetries is the number of times a failed Akuity Platform API call is retried when `max_retries` is not set.
+const defaultMaxRetries = 5
+
+// defaultRetryBackoff spaces retries of failed Akuity Platform API calls.
+var defaultRetryBackoff = waiter.Config{
+	InitialInterval: 1 * time.Second,
+	MaxInterval:     30 * time.Second,
+	Multiplier:      2,
+	Jitter:          0.2,
+}
+
+// retryPolicy decides whether and how often a failed API call is retried.
+type retryPolicy struct {
+	maxRetries int
+	backoff    waiter.Config
+}
+
+// isTransient reports whether err is a throttling or availability error returned by the gateway.
+// The gateway maps 429, 502, 503 and 504 responses to Unavailable. Unknown is not retried, the gateway also maps
+// plain 500 responses and 4xx responses without a gRPC status to it.
+func isTransient(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch s.Code() {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// isThrottled reports whether err means the request was rejected before being processed,
+// which makes it safe to retry even calls that are not idempotent.
+func isThrottled(err error) bool {
+	return status.Code(err) == codes.ResourceExhausted
+}
+
+func withRetry[T any](ctx context.Context, p retryPolicy, idempotent bool, call func(context.Context) (T, error)) (T, error) {
+	retryable := isThrottled
+	if idempotent {
+		retryable = isTransient
+	}
+	var res T
+	err := waiter.Retry(ctx, p.backoff, p.maxRetries, retryable, func(ctx context.Context) error {
+		var err error
+		res, err = call(ctx)
+		return err
+	})
+	return res, err
+}
+
+// withDeleteRetry retries a delete call. A NotFound error after a retry means an earlier attempt went through.
+func withDeleteRetry[T any](ctx context.Context, p retryPolicy, call func(context.Context) (T, error)) (T, error) {
+	attempts := 0
+	return withRetry(ctx, p, true, func(ctx context.Context) (T, error) {
+		attempts++
+		res, err := call(ctx)
+		if attempts > 1 && status.Code(err) == codes.NotFound {
+			return res, nil
+		}
+		return res, err
+	})
+}
+
+type streamResult struct {
+	resChan <-chan *httpbody.HttpBody
+	errChan <-chan error
+}
+
+// retryingArgoCDClient retries the Argo CD API calls used by the provider on transient errors.
+// Calls that are not overridden here are passed through as is.
+type retryingArgoCDClient struct {
+	argocdv1.ArgoCDServiceGatewayClient
+	policy retryPolicy
+}
+
+func (c *retryingArgoCDClient) ListInstances(ctx context.Context, req *argocdv1.ListInstancesRequest) (*argocdv1.ListInstancesResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*argocdv1.ListInstancesResponse, error) {
+		return c.ArgoCDServiceGatewayClient.ListInstances(ctx, req)
+	})
+}
+
+func (c *retryingArgoCDClient) GetInstance(ctx context.Context, req *argocdv1.GetInstanceRequest) (*argocdv1.GetInstanceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*argocdv1.GetInstanceResponse, error) {
+		return c.ArgoCDServiceGatewayClient.GetInstance(ctx, req)
+	})
+}
+
+func (c *retryingArgoCDClient) DeleteInstance(ctx context.Context, req *argocdv1.DeleteInstanceRequest) (*argocdv1.DeleteInstanceResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*argocdv1.DeleteInstanceResponse, error) {
+		return c.ArgoCDServiceGatewayClient.DeleteInstance(ctx, req)
+	})
+}
+
+func (c *retryingArgoCDClient) ListInstanceClusters(ctx context.Context, req *argocdv1.ListInstanceClustersRequest) (*argocdv1.ListInstanceClustersResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*argocdv1.ListInstanceClustersResponse, error) {
+		return c.ArgoCDServiceGatewayClient.ListInstanceClusters(ctx, req)
+	})
+}
+
+func (c *retryingArgoCDClient) GetInstanceCluster(ctx context.Context, req *argocdv1.GetInstanceClusterRequest) (*argocdv1.GetInstanceClusterResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*argocdv1.GetInstanceClusterResponse, error) {
+		return c.ArgoCDServiceGatewayClient.GetInstanceCluster(ctx, req)
+	})
+}
+
+func (c *retryingArgoCDClient) GetInstanceClusterManifests(ctx context.Context, req *argocdv1.GetInstanceClusterManifestsRequest) (<-chan *httpbody.HttpBody, <-chan error, error) {
+	res, err := withRetry(ctx, c.policy, true, func(ctx context.Context) (streamResult, error) {
+		resChan, errChan, err := c.ArgoCDServiceGatewayClient.GetInstanceClusterManifests(ctx, req)
+		return streamResult{resChan: resChan, errChan: errChan}, err
+	})
+	return res.resChan, res.errChan, err
+}
+
+func (c *retryingArgoCDClient) DeleteInstanceCluster(ctx context.Context, req *argocdv1.DeleteInstanceClusterRequest) (*argocdv1.DeleteInstanceClusterResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*argocdv1.DeleteInstanceClusterResponse, error) {
+		return c.ArgoCDServiceGatewayClient.DeleteInstanceCluster(ctx, req)
+	})
+}
+
+// ApplyInstance is declarative, applying the same request twice yields the same result.
+func (c *retryingArgoCDClient) ApplyInstance(ctx context.Context, req *argocdv1.ApplyInstanceRequest) (*argocdv1.ApplyInstanceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*argocdv1.ApplyInstanceResponse, error) {
+		return c.ArgoCDServiceGatewayClient.ApplyInstance(ctx, req)
+	})
+}
+
+func (c *retryingArgoCDClient) UpdateInstanceWorkspace(ctx context.Context, req *argocdv1.UpdateInstanceWorkspaceRequest) (*argocdv1.UpdateInstanceWorkspaceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*argocdv1.UpdateInstanceWorkspaceResponse, error) {
+		return c.ArgoCDServiceGatewayClient.UpdateInstanceWorkspace(ctx, req)
+	})
+}
+
+func (c *retryingArgoCDClient) ExportInstance(ctx context.Context, req *argocdv1.ExportInstanceRequest) (*argocdv1.ExportInstanceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*argocdv1.ExportInstanceResponse, error) {
+		return c.ArgoCDServiceGatewayClient.ExportInstance(ctx, req)
+	})
+}
+
+// retryingKargoClient retries the Kargo API calls used by the provider on transient errors.
+// Calls that are not overridden here are passed through as is.
+type retryingKargoClient struct {
+	kargov1.KargoServiceGatewayClient
+	policy retryPolicy
+}
+
+func (c *retryingKargoClient) ListKargoInstances(ctx context.Context, req *kargov1.ListKargoInstancesRequest) (*kargov1.ListKargoInstancesResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*kargov1.ListKargoInstancesResponse, error) {
+		return c.KargoServiceGatewayClient.ListKargoInstances(ctx, req)
+	})
+}
+
+func (c *retryingKargoClient) GetKargoInstance(ctx context.Context, req *kargov1.GetKargoInstanceRequest) (*kargov1.GetKargoInstanceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*kargov1.GetKargoInstanceResponse, error) {
+		return c.KargoServiceGatewayClient.GetKargoInstance(ctx, req)
+	})
+}
+
+func (c *retryingKargoClient) ListKargoInstanceAgents(ctx context.Context, req *kargov1.ListKargoInstanceAgentsRequest) (*kargov1.ListKargoInstanceAgentsResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*kargov1.ListKargoInstanceAgentsResponse, error) {
+		return c.KargoServiceGatewayClient.ListKargoInstanceAgents(ctx, req)
+	})
+}
+
+func (c *retryingKargoClient) GetKargoInstanceAgent(ctx context.Context, req *kargov1.GetKargoInstanceAgentRequest) (*kargov1.GetKargoInstanceAgentResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*kargov1.GetKargoInstanceAgentResponse, error) {
+		return c.KargoServiceGatewayClient.GetKargoInstanceAgent(ctx, req)
+	})
+}
+
+func (c *retryingKargoClient) GetKargoInstanceAgentManifests(ctx context.Context, req *kargov1.GetKargoInstanceAgentManifestsRequest) (<-chan *httpbody.HttpBody, <-chan error, error) {
+	res, err := withRetry(ctx, c.policy, true, func(ctx context.Context) (streamResult, error) {
+		resChan, errChan, err := c.KargoServiceGatewayClient.GetKargoInstanceAgentManifests(ctx, req)
+		return streamResult{resChan: resChan, errChan: errChan}, err
+	})
+	return res.resChan, res.errChan, err
+}
+
+func (c *retryingKargoClient) DeleteInstance(ctx context.Context, req *kargov1.DeleteInstanceRequest) (*kargov1.DeleteInstanceResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*kargov1.DeleteInstanceResponse, error) {
+		return c.KargoServiceGatewayClient.DeleteInstance(ctx, req)
+	})
+}
+
+func (c *retryingKargoClient) DeleteInstanceAgent(ctx context.Context, req *kargov1.DeleteInstanceAgentRequest) (*kargov1.DeleteInstanceAgentResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*kargov1.DeleteInstanceAgentResponse, error) {
+		return c.KargoServiceGatewayClient.DeleteInstanceAgent(ctx, req)
+	})
+}
+
+// ApplyKargoInstance is declarative, applying the same request twice yields the same result.
+func (c *retryingKargoClient) ApplyKargoInstance(ctx context.Context, req *kargov1.ApplyKargoInstanceRequest) (*kargov1.ApplyKargoInstanceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*kargov1.ApplyKargoInstanceResponse, error) {
+		return c.KargoServiceGatewayClient.ApplyKargoInstance(ctx, req)
+	})
+}
+
+func (c *retryingKargoClient) UpdateKargoInstanceWorkspace(ctx context.Context, req *kargov1.UpdateKargoInstanceWorkspaceRequest) (*kargov1.UpdateKargoInstanceWorkspaceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*kargov1.UpdateKargoInstanceWorkspaceResponse, error) {
+		return c.KargoServiceGatewayClient.UpdateKargoInstanceWorkspace(ctx, req)
+	})
+}
+
+func (c *retryingKargoClient) ExportKargoInstance(ctx context.Context, req *kargov1.ExportKargoInstanceRequest) (*kargov1.ExportKargoInstanceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*kargov1.ExportKargoInstanceResponse, error) {
+		return c.KargoServiceGatewayClient.ExportKargoInstance(ctx, req)
+	})
+}
+
+// retryingOrgClient retries the organization API calls used by the provider on transient errors.
+// Calls that are not overridden here are passed through as is.
+type retryingOrgClient struct {
+	orgcv1.OrganizationServiceGatewayClient
+	policy retryPolicy
+}
+
+func (c *retryingOrgClient) GetOrganization(ctx context.Context, req *orgcv1.GetOrganizationRequest) (*orgcv1.GetOrganizationResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.GetOrganizationResponse, error) {
+		return c.OrganizationServiceGatewayClient.GetOrganization(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) ListWorkspaces(ctx context.Context, req *orgcv1.ListWorkspacesRequest) (*orgcv1.ListWorkspacesResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.ListWorkspacesResponse, error) {
+		return c.OrganizationServiceGatewayClient.ListWorkspaces(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) GetWorkspace(ctx context.Context, req *orgcv1.GetWorkspaceRequest) (*orgcv1.GetWorkspaceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.GetWorkspaceResponse, error) {
+		return c.OrganizationServiceGatewayClient.GetWorkspace(ctx, req)
+	})
+}
+
+// CreateWorkspace is not idempotent, it is only retried when the request was throttled.
+func (c *retryingOrgClient) CreateWorkspace(ctx context.Context, req *orgcv1.CreateWorkspaceRequest) (*orgcv1.CreateWorkspaceResponse, error) {
+	return withRetry(ctx, c.policy, false, func(ctx context.Context) (*orgcv1.CreateWorkspaceResponse, error) {
+		return c.OrganizationServiceGatewayClient.CreateWorkspace(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) UpdateWorkspace(ctx context.Context, req *orgcv1.UpdateWorkspaceRequest) (*orgcv1.UpdateWorkspaceResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.UpdateWorkspaceResponse, error) {
+		return c.OrganizationServiceGatewayClient.UpdateWorkspace(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) DeleteWorkspace(ctx context.Context, req *orgcv1.DeleteWorkspaceRequest) (*orgcv1.DeleteWorkspaceResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*orgcv1.DeleteWorkspaceResponse, error) {
+		return c.OrganizationServiceGatewayClient.DeleteWorkspace(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) ListWorkspaceMembers(ctx context.Context, req *orgcv1.ListWorkspaceMembersRequest) (*orgcv1.ListWorkspaceMembersResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.ListWorkspaceMembersResponse, error) {
+		return c.OrganizationServiceGatewayClient.ListWorkspaceMembers(ctx, req)
+	})
+}
+
+// UpdateWorkspaceMembers replaces the whole member list, applying the same request twice yields the same result.
+func (c *retryingOrgClient) UpdateWorkspaceMembers(ctx context.Context, req *orgcv1.UpdateWorkspaceMembersRequest) (*orgcv1.UpdateWorkspaceMembersResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.UpdateWorkspaceMembersResponse, error) {
+		return c.OrganizationServiceGatewayClient.UpdateWorkspaceMembers(ctx, req)
+	})
+}
+
+// CreateOrganizationAPIKey is not idempotent, it is only retried when the request was throttled.
+func (c *retryingOrgClient) CreateOrganizationAPIKey(ctx context.Context, req *orgcv1.CreateOrganizationAPIKeyRequest) (*orgcv1.CreateOrganizationAPIKeyResponse, error) {
+	return withRetry(ctx, c.policy, false, func(ctx context.Context) (*orgcv1.CreateOrganizationAPIKeyResponse, error) {
+		return c.OrganizationServiceGatewayClient.CreateOrganizationAPIKey(ctx, req)
+	})
+}
+
+// CreateWorkspaceAPIKey is not idempotent, it is only retried when the request was throttled.
+func (c *retryingOrgClient) CreateWorkspaceAPIKey(ctx context.Context, req *orgcv1.CreateWorkspaceAPIKeyRequest) (*orgcv1.CreateWorkspaceAPIKeyResponse, error) {
+	return withRetry(ctx, c.policy, false, func(ctx context.Context) (*orgcv1.CreateWorkspaceAPIKeyResponse, error) {
+		return c.OrganizationServiceGatewayClient.CreateWorkspaceAPIKey(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) ListOrganizationMembers(ctx context.Context, req *orgcv1.ListOrganizationMembersRequest) (*orgcv1.ListOrganizationMembersResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.ListOrganizationMembersResponse, error) {
+		return c.OrganizationServiceGatewayClient.ListOrganizationMembers(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) ListOrganizationInvitees(ctx context.Context, req *orgcv1.ListOrganizationInviteesRequest) (*orgcv1.ListOrganizationInviteesResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.ListOrganizationInviteesResponse, error) {
+		return c.OrganizationServiceGatewayClient.ListOrganizationInvitees(ctx, req)
+	})
+}
+
+// InviteMembers is not idempotent, it is only retried when the request was throttled.
+func (c *retryingOrgClient) InviteMembers(ctx context.Context, req *orgcv1.InviteMembersRequest) (*orgcv1.InviteMembersResponse, error) {
+	return withRetry(ctx, c.policy, false, func(ctx context.Context) (*orgcv1.InviteMembersResponse, error) {
+		return c.OrganizationServiceGatewayClient.InviteMembers(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) UninviteOrganizationMember(ctx context.Context, req *orgcv1.UninviteOrganizationMemberRequest) (*orgcv1.UninviteOrganizationMemberResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*orgcv1.UninviteOrganizationMemberResponse, error) {
+		return c.OrganizationServiceGatewayClient.UninviteOrganizationMember(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) RemoveOrganizationMember(ctx context.Context, req *orgcv1.RemoveOrganizationMemberRequest) (*orgcv1.RemoveOrganizationMemberResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*orgcv1.RemoveOrganizationMemberResponse, error) {
+		return c.OrganizationServiceGatewayClient.RemoveOrganizationMember(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) UpdateOrganizationMemberRole(ctx context.Context, req *orgcv1.UpdateOrganizationMemberRoleRequest) (*orgcv1.UpdateOrganizationMemberRoleResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.UpdateOrganizationMemberRoleResponse, error) {
+		return c.OrganizationServiceGatewayClient.UpdateOrganizationMemberRole(ctx, req)
+	})
+}
+
+// CreateTeam is not idempotent, it is only retried when the request was throttled.
+func (c *retryingOrgClient) CreateTeam(ctx context.Context, req *orgcv1.CreateTeamRequest) (*orgcv1.CreateTeamResponse, error) {
+	return withRetry(ctx, c.policy, false, func(ctx context.Context) (*orgcv1.CreateTeamResponse, error) {
+		return c.OrganizationServiceGatewayClient.CreateTeam(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) GetTeam(ctx context.Context, req *orgcv1.GetTeamRequest) (*orgcv1.GetTeamResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.GetTeamResponse, error) {
+		return c.OrganizationServiceGatewayClient.GetTeam(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) UpdateTeam(ctx context.Context, req *orgcv1.UpdateTeamRequest) (*orgcv1.UpdateTeamResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.UpdateTeamResponse, error) {
+		return c.OrganizationServiceGatewayClient.UpdateTeam(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) DeleteTeam(ctx context.Context, req *orgcv1.DeleteTeamRequest) (*orgcv1.DeleteTeamResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*orgcv1.DeleteTeamResponse, error) {
+		return c.OrganizationServiceGatewayClient.DeleteTeam(ctx, req)
+	})
+}
+
+// AddTeamMember is not idempotent, it is only retried when the request was throttled.
+func (c *retryingOrgClient) AddTeamMember(ctx context.Context, req *orgcv1.AddTeamMemberRequest) (*orgcv1.AddTeamMemberResponse, error) {
+	return withRetry(ctx, c.policy, false, func(ctx context.Context) (*orgcv1.AddTeamMemberResponse, error) {
+		return c.OrganizationServiceGatewayClient.AddTeamMember(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) GetTeamMember(ctx context.Context, req *orgcv1.GetTeamMemberRequest) (*orgcv1.GetTeamMemberResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.GetTeamMemberResponse, error) {
+		return c.OrganizationServiceGatewayClient.GetTeamMember(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) RemoveTeamMember(ctx context.Context, req *orgcv1.RemoveTeamMemberRequest) (*orgcv1.RemoveTeamMemberResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*orgcv1.RemoveTeamMemberResponse, error) {
+		return c.OrganizationServiceGatewayClient.RemoveTeamMember(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) GetSSOConfiguration(ctx context.Context, req *orgcv1.GetSSOConfigurationRequest) (*orgcv1.GetSSOConfigurationResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.GetSSOConfigurationResponse, error) {
+		return c.OrganizationServiceGatewayClient.GetSSOConfiguration(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) EnsureSSOConfiguration(ctx context.Context, req *orgcv1.EnsureSSOConfigurationRequest) (*orgcv1.EnsureSSOConfigurationResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.EnsureSSOConfigurationResponse, error) {
+		return c.OrganizationServiceGatewayClient.EnsureSSOConfiguration(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) DeleteSSOConfiguration(ctx context.Context, req *orgcv1.DeleteSSOConfigurationRequest) (*orgcv1.DeleteSSOConfigurationResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*orgcv1.DeleteSSOConfigurationResponse, error) {
+		return c.OrganizationServiceGatewayClient.DeleteSSOConfiguration(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) GetOIDCMap(ctx context.Context, req *orgcv1.GetOIDCMapRequest) (*orgcv1.GetOIDCMapResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.GetOIDCMapResponse, error) {
+		return c.OrganizationServiceGatewayClient.GetOIDCMap(ctx, req)
+	})
+}
+
+// UpdateOIDCMap replaces the whole map, applying the same request twice yields the same result.
+func (c *retryingOrgClient) UpdateOIDCMap(ctx context.Context, req *orgcv1.UpdateOIDCMapRequest) (*orgcv1.UpdateOIDCMapResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.UpdateOIDCMapResponse, error) {
+		return c.OrganizationServiceGatewayClient.UpdateOIDCMap(ctx, req)
+	})
+}
+
+func (c *retryingOrgClient) GetTeamOIDCMap(ctx context.Context, req *orgcv1.GetTeamOIDCMapRequest) (*orgcv1.GetTeamOIDCMapResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.GetTeamOIDCMapResponse, error) {
+		return c.OrganizationServiceGatewayClient.GetTeamOIDCMap(ctx, req)
+	})
+}
+
+// UpdateTeamOIDCMap replaces the whole map, applying the same request twice yields the same result.
+func (c *retryingOrgClient) UpdateTeamOIDCMap(ctx context.Context, req *orgcv1.UpdateTeamOIDCMapRequest) (*orgcv1.UpdateTeamOIDCMapResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*orgcv1.UpdateTeamOIDCMapResponse, error) {
+		return c.OrganizationServiceGatewayClient.UpdateTeamOIDCMap(ctx, req)
+	})
+}
+
+// retryingAPIKeyClient retries the API key calls used by the provider on transient errors.
+// Calls that are not overridden here are passed through as is.
+type retryingAPIKeyClient struct {
+	apikeyv1.APIKeyServiceGatewayClient
+	policy retryPolicy
+}
+
+func (c *retryingAPIKeyClient) GetAPIKey(ctx context.Context, req *apikeyv1.GetAPIKeyRequest) (*apikeyv1.GetAPIKeyResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*apikeyv1.GetAPIKeyResponse, error) {
+		return c.APIKeyServiceGatewayClient.GetAPIKey(ctx, req)
+	})
+}
+
+func (c *retryingAPIKeyClient) DeleteAPIKey(ctx context.Context, req *apikeyv1.DeleteAPIKeyRequest) (*apikeyv1.DeleteAPIKeyResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*apikeyv1.DeleteAPIKeyResponse, error) {
+		return c.APIKeyServiceGatewayClient.DeleteAPIKey(ctx, req)
+	})
+}
+
+// RegenerateAPIKeySecret is not idempotent, it is only retried when the request was throttled.
+func (c *retryingAPIKeyClient) RegenerateAPIKeySecret(ctx context.Context, req *apikeyv1.RegenerateAPIKeySecretRequest) (*apikeyv1.RegenerateAPIKeySecretResponse, error) {
+	return withRetry(ctx, c.policy, false, func(ctx context.Context) (*apikeyv1.RegenerateAPIKeySecretResponse, error) {
+		return c.APIKeyServiceGatewayClient.RegenerateAPIKeySecret(ctx, req)
+	})
+}
+
+func (c *retryingAPIKeyClient) GetWorkspaceAPIKey(ctx context.Context, req *apikeyv1.GetWorkspaceAPIKeyRequest) (*apikeyv1.GetWorkspaceAPIKeyResponse, error) {
+	return withRetry(ctx, c.policy, true, func(ctx context.Context) (*apikeyv1.GetWorkspaceAPIKeyResponse, error) {
+		return c.APIKeyServiceGatewayClient.GetWorkspaceAPIKey(ctx, req)
+	})
+}
+
+func (c *retryingAPIKeyClient) DeleteWorkspaceAPIKey(ctx context.Context, req *apikeyv1.DeleteWorkspaceAPIKeyRequest) (*apikeyv1.DeleteWorkspaceAPIKeyResponse, error) {
+	return withDeleteRetry(ctx, c.policy, func(ctx context.Context) (*apikeyv1.DeleteWorkspaceAPIKeyResponse, error) {
+		return c.APIKeyServiceGatewayClient.DeleteWorkspaceAPIKey(ctx, req)
+	})
+}
+
+// RegenerateWorkspaceAPIKeySecret is not idempotent, it is only retried when the request was throttled.
+func (c *retryingAPIKeyClient) RegenerateWorkspaceAPIKeySecret(ctx context.Context, req *apikeyv1.RegenerateWorkspaceAPIKeySecretRequest) (*apikeyv1.RegenerateWorkspaceAPIKeySecretResponse, error) {
+	return withRetry(ctx, c.policy, false, func(ctx context.Context) (*apikeyv1.RegenerateWorkspaceAPIKeySecretResponse, error) {
+		return c.APIKeyServiceGatewayClient.RegenerateWorkspaceAPIKeySecret(ctx, req)
+	})
+}
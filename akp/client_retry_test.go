@@ -0,0 +1,116 @@
+package akp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/akuity/terraform-provider-akp/akp/waiter"
+)
+
+var testRetryPolicy = retryPolicy{
+	maxRetries: 3,
+	backoff: waiter.Config{
+		InitialInterval: 1 * time.Millisecond,
+		MaxInterval:     1 * time.Millisecond,
+	},
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: status.Error(codes.Unavailable, "unavailable"), want: true},
+		{err: status.Error(codes.ResourceExhausted, "throttled"), want: true},
+		{err: status.Error(codes.Unknown, "internal server error"), want: false},
+		{err: status.Error(codes.InvalidArgument, "invalid"), want: false},
+		{err: status.Error(codes.NotFound, "not found"), want: false},
+		{err: status.Error(codes.PermissionDenied, "denied"), want: false},
+		{err: errors.New("not a status"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assert.Equal(t, tt.want, isTransient(tt.err))
+		})
+	}
+}
+
+func TestRetryingArgoCDClient_GetInstanceCluster(t *testing.T) {
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{
+			name:      "transient errors are retried",
+			errs:      []error{status.Error(codes.Unavailable, "unavailable"), status.Error(codes.ResourceExhausted, "throttled"), nil},
+			wantCalls: 3,
+			wantCode:  codes.OK,
+		},
+		{
+			name:      "other errors are not retried",
+			errs:      []error{status.Error(codes.InvalidArgument, "invalid"), nil},
+			wantCalls: 1,
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "retries are exhausted",
+			errs:      []error{status.Error(codes.Unavailable, "1"), status.Error(codes.Unavailable, "2"), status.Error(codes.Unavailable, "3"), status.Error(codes.Unavailable, "4"), nil},
+			wantCalls: 4,
+			wantCode:  codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := &retryingArgoCDClient{
+				ArgoCDServiceGatewayClient: &fakeArgoCDClient{
+					getInstanceCluster: func(ctx context.Context, req *argocdv1.GetInstanceClusterRequest) (*argocdv1.GetInstanceClusterResponse, error) {
+						err := tt.errs[calls]
+						calls++
+						if err != nil {
+							return nil, err
+						}
+						return &argocdv1.GetInstanceClusterResponse{}, nil
+					},
+				},
+				policy: testRetryPolicy,
+			}
+			_, err := client.GetInstanceCluster(context.Background(), &argocdv1.GetInstanceClusterRequest{})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestRetryingArgoCDClient_DeleteInstanceCluster(t *testing.T) {
+	calls := 0
+	client := &retryingArgoCDClient{
+		ArgoCDServiceGatewayClient: &fakeArgoCDClient{
+			deleteInstanceCluster: func(ctx context.Context, req *argocdv1.DeleteInstanceClusterRequest) (*argocdv1.DeleteInstanceClusterResponse, error) {
+				calls++
+				if calls == 1 {
+					// The first attempt went through, but the response got lost.
+					return nil, status.Error(codes.Unavailable, "unavailable")
+				}
+				return nil, status.Error(codes.NotFound, "not found")
+			},
+		},
+		policy: testRetryPolicy,
+	}
+	_, err := client.DeleteInstanceCluster(context.Background(), &argocdv1.DeleteInstanceClusterRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	calls = 1
+	_, err = client.DeleteInstanceCluster(context.Background(), &argocdv1.DeleteInstanceClusterRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err), "NotFound on the first attempt must be reported")
+}
@@ -0,0 +1,97 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/kube"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &AkpClusterManifestsDataSource{}
+
+func NewAkpClusterManifestsDataSource() datasource.DataSource {
+	return &AkpClusterManifestsDataSource{}
+}
+
+// AkpClusterManifestsDataSource defines the data source implementation.
+type AkpClusterManifestsDataSource struct {
+	akpCli *AkpCli
+}
+
+func (d *AkpClusterManifestsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_manifests"
+}
+
+func (d *AkpClusterManifestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.akpCli = akpCli
+}
+
+func (d *AkpClusterManifestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading a Cluster Manifests Datasource")
+	var data types.AgentManifests
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+	ctx = httpctx.SetAuthorizationHeader(ctx, d.akpCli.Cred.Scheme(), d.akpCli.Cred.Credential())
+	manifests, err := getManifests(ctx, d.akpCli.Cli, d.akpCli.Poll, d.akpCli.OrgId, &types.Cluster{
+		InstanceID: data.InstanceID,
+		Name:       data.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if err := refreshAgentManifests(&data, manifests); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// refreshAgentManifests sets the raw manifests and their documents, one per Kubernetes object in the order of the manifests.
+func refreshAgentManifests(data *types.AgentManifests, manifests string) error {
+	objs, err := kube.SplitYAML([]byte(manifests))
+	if err != nil {
+		return errors.Wrap(err, "Unable to split manifests")
+	}
+	documents := make([]tftypes.String, 0, len(objs))
+	for _, obj := range objs {
+		document, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to marshal %s %s", obj.GetKind(), obj.GetName())
+		}
+		documents = append(documents, tftypes.StringValue(string(document)))
+	}
+	data.Manifests = tftypes.StringValue(manifests)
+	data.Documents = documents
+	return nil
+}
@@ -0,0 +1,35 @@
+//go:build !unit
+
+package akp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterManifestsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccClusterManifestsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.akp_cluster_manifests.test", "instance_id", "6pzhawvy4echbd8x"),
+					resource.TestCheckResourceAttr("data.akp_cluster_manifests.test", "name", "data-source-cluster"),
+					resource.TestCheckResourceAttrSet("data.akp_cluster_manifests.test", "manifests"),
+					resource.TestCheckResourceAttrSet("data.akp_cluster_manifests.test", "documents.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccClusterManifestsDataSourceConfig = `
+data "akp_cluster_manifests" "test" {
+  instance_id = "6pzhawvy4echbd8x"
+  name = "data-source-cluster"
+}
+`
@@ -57,6 +57,11 @@ func getAKPClusterDataSourceAttributes() map[string]schema.Attribute {
 			MarkdownDescription: "Remove agent Kubernetes resources from the managed cluster when destroying cluster",
 			Computed:            true,
 		},
+		"timeouts": schema.SingleNestedAttribute{
+			MarkdownDescription: "Timeouts for the cluster operations",
+			Computed:            true,
+			Attributes:          getTimeoutsDataSourceAttributes(),
+		},
 	}
 }
 
@@ -138,6 +143,27 @@ func getClusterDataDataSourceAttributes() map[string]schema.Attribute {
 	}
 }
 
+func getTimeoutsDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"create": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timeout for creating the resource.",
+		},
+		"update": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timeout for updating the resource.",
+		},
+		"delete": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timeout for deleting the resource.",
+		},
+		"read": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timeout for reading the resource.",
+		},
+	}
+}
+
 func getKubeconfigDataSourceAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"host": schema.StringAttribute{
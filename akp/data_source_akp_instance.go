@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
 	"github.com/akuity/terraform-provider-akp/akp/types"
 )
@@ -57,6 +59,22 @@ func (r *AkpInstanceDataSource) Read(ctx context.Context, req datasource.ReadReq
 	tflog.MaskLogStrings(ctx, data.GetSensitiveStrings(ctx, &resp.Diagnostics)...)
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
 
-	refreshState(ctx, &resp.Diagnostics, r.akpCli.Cli, &data, r.akpCli.OrgId)
+	var workspace *orgcv1.Workspace
+	if !data.Workspace.IsNull() {
+		var err error
+		workspace, err = getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, data.Workspace.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("workspace"), "Client Error", err.Error())
+			return
+		}
+	}
+	if err := refreshState(ctx, &resp.Diagnostics, r.akpCli.Cli, r.akpCli.OrgCli, &data, r.akpCli.OrgId); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if workspace != nil && data.Workspace.ValueString() != workspace.GetId() && data.Workspace.ValueString() != workspace.GetName() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Argo CD instance %q not found in workspace %q", data.Name.ValueString(), workspace.GetName()))
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -102,6 +102,20 @@ func getAKPInstanceDataSourceAttributes() map[string]schema.Attribute {
 				Attributes: getAKPConfigManagementPluginDataSourceAttributes(),
 			},
 		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "Workspace of the instance, given by name or ID. When set, the instance must belong to this workspace",
+			Optional:            true,
+			Computed:            true,
+		},
+		"wait_for_healthy": schema.BoolAttribute{
+			MarkdownDescription: "Wait for the instance to become healthy and reconciled after it is created or updated",
+			Computed:            true,
+		},
+		"timeouts": schema.SingleNestedAttribute{
+			MarkdownDescription: "Timeouts for the instance operations",
+			Computed:            true,
+			Attributes:          getTimeoutsDataSourceAttributes(),
+		},
 	}
 }
 
@@ -0,0 +1,39 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *AkpInstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets information about all Argo CD instances in the organization. Instances can be filtered by name and workspace, not by label: the Akuity Platform API does not return labels for Argo CD instances",
+		Attributes:          getAKPInstancesDataSourceAttributes(),
+	}
+}
+
+func getAKPInstancesDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Organization ID",
+			Computed:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: "Only return the instances whose name matches this regular expression, e.g. `^prod-`",
+			Optional:            true,
+		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "Only return the instances of this workspace, given by name or ID",
+			Optional:            true,
+		},
+		"instances": schema.ListNestedAttribute{
+			MarkdownDescription: "List of Argo CD instances",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: getAKPInstanceDataSourceAttributes(),
+			},
+		},
+	}
+}
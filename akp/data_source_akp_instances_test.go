@@ -0,0 +1,34 @@
+//go:build !unit
+
+package akp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInstancesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccInstancesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.akp_instances.test", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.akp_instances.test", "instances.0.id", "6pzhawvy4echbd8x"),
+					resource.TestCheckResourceAttr("data.akp_instances.test", "instances.0.name", "test-cluster"),
+					resource.TestCheckResourceAttr("data.akp_instances.test", "instances.0.argocd.spec.version", "v2.13.1"),
+					resource.TestCheckResourceAttr("data.akp_instances.test", "instances.0.argocd.spec.instance_spec.subdomain", "6pzhawvy4echbd8x"),
+				),
+			},
+		},
+	})
+}
+
+const testAccInstancesDataSourceConfig = `
+data "akp_instances" "test" {
+  name_regex = "^test-cluster$"
+}
+`
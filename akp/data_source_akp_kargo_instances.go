@@ -0,0 +1,133 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &AkpKargoInstancesDataSource{}
+
+func NewAkpKargoInstancesDataSource() datasource.DataSource {
+	return &AkpKargoInstancesDataSource{}
+}
+
+// AkpKargoInstancesDataSource defines the data source implementation.
+type AkpKargoInstancesDataSource struct {
+	akpCli *AkpCli
+}
+
+func (d *AkpKargoInstancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kargo_instances"
+}
+
+func (d *AkpKargoInstancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.akpCli = akpCli
+}
+
+func (d *AkpKargoInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading a Kargo Instances Datasource")
+	var data types.KargoInstances
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+			return
+		}
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, d.akpCli.Cred.Scheme(), d.akpCli.Cred.Credential())
+
+	apiReq := &kargov1.ListKargoInstancesRequest{
+		OrganizationId: d.akpCli.OrgId,
+	}
+	if !data.Workspace.IsNull() {
+		workspace, err := getWorkspace(ctx, d.akpCli.OrgCli, d.akpCli.OrgId, data.Workspace.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("workspace"), "Client Error", err.Error())
+			return
+		}
+		apiReq.WorkspaceId = workspace.GetId()
+	}
+	workspaces, err := d.akpCli.OrgCli.ListWorkspaces(ctx, &orgcv1.ListWorkspacesRequest{
+		OrganizationId: d.akpCli.OrgId,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspaces, got error: %s", err))
+		return
+	}
+	workspaceNames := map[string]string{}
+	for _, w := range workspaces.GetWorkspaces() {
+		workspaceNames[w.GetId()] = w.GetName()
+	}
+	tflog.Debug(ctx, fmt.Sprintf("List Kargo instances request: %s", apiReq))
+	apiResp, err := d.akpCli.KargoCli.ListKargoInstances(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Kargo instances, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("List Kargo instances response: %s", apiResp))
+
+	data.ID = tftypes.StringValue(d.akpCli.OrgId)
+	data.Instances = nil
+	for _, instance := range apiResp.GetInstances() {
+		if nameRegex != nil && !nameRegex.MatchString(instance.GetName()) {
+			continue
+		}
+		exportReq := &kargov1.ExportKargoInstanceRequest{
+			OrganizationId: d.akpCli.OrgId,
+			Id:             instance.GetId(),
+			WorkspaceId:    instance.GetWorkspaceId(),
+		}
+		exportResp, err := d.akpCli.KargoCli.ExportKargoInstance(ctx, exportReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export Kargo instance %q, got error: %s", instance.GetName(), err))
+			return
+		}
+		stateInstance := types.KargoInstance{
+			ID:        tftypes.StringValue(instance.GetId()),
+			Name:      tftypes.StringValue(instance.GetName()),
+			Workspace: tftypes.StringValue(workspaceNames[instance.GetWorkspaceId()]),
+		}
+		if err := stateInstance.Update(ctx, &resp.Diagnostics, exportResp); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		data.Instances = append(data.Instances, stateInstance)
+	}
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *AkpKargoInstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets information about all Kargo instances in the organization",
+		Attributes:          getAKPKargoInstancesDataSourceAttributes(),
+	}
+}
+
+func getAKPKargoInstancesDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Organization ID",
+			Computed:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: "Only return the Kargo instances whose name matches this regular expression, e.g. `^prod-`",
+			Optional:            true,
+		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "Only return the Kargo instances of this workspace, given by name or ID",
+			Optional:            true,
+		},
+		"instances": schema.ListNestedAttribute{
+			MarkdownDescription: "List of Kargo instances",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: getAKPKargoDataSourceAttributes(),
+			},
+		},
+	}
+}
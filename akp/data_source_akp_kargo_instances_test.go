@@ -0,0 +1,34 @@
+//go:build !unit
+
+package akp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKargoInstancesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccKargoInstancesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.akp_kargo_instances.test", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.akp_kargo_instances.test", "instances.0.id", "5gjcg0rh8fjemhc0"),
+					resource.TestCheckResourceAttr("data.akp_kargo_instances.test", "instances.0.name", "test-instance"),
+					resource.TestCheckResourceAttr("data.akp_kargo_instances.test", "instances.0.kargo.spec.version", "v1.2.2"),
+					resource.TestCheckResourceAttr("data.akp_kargo_instances.test", "instances.0.kargo_cm.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKargoInstancesDataSourceConfig = `
+data "akp_kargo_instances" "test" {
+  name_regex = "^test-instance$"
+}
+`
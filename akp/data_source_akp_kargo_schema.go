@@ -40,6 +40,19 @@ func getAKPKargoDataSourceAttributes() map[string]schema.Attribute {
 			ElementType:         types.StringType,
 			Computed:            true,
 		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "Workspace of the Kargo instance",
+			Computed:            true,
+		},
+		"wait_for_healthy": schema.BoolAttribute{
+			MarkdownDescription: "Wait for the Kargo instance to become healthy and reconciled after it is created or updated",
+			Computed:            true,
+		},
+		"timeouts": schema.SingleNestedAttribute{
+			MarkdownDescription: "Timeouts for the Kargo instance operations",
+			Computed:            true,
+			Attributes:          getTimeoutsDataSourceAttributes(),
+		},
 	}
 }
 
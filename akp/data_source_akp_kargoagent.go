@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
@@ -53,6 +54,12 @@ func (a *AkpKargoAgentDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 	ctx = httpctx.SetAuthorizationHeader(ctx, a.akpCli.Cred.Scheme(), a.akpCli.Cred.Credential())
 	refreshKargoAgentState(ctx, &resp.Diagnostics, a.akpCli.KargoCli, &data, a.akpCli.OrgId, &resp.State, &data)
+	workspace, err := getKargoInstanceWorkspace(ctx, a.akpCli.KargoCli, a.akpCli.OrgCli, a.akpCli.OrgId, data.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	data.Workspace = tftypes.StringValue(workspace.GetName())
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
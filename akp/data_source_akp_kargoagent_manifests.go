@@ -0,0 +1,74 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &AkpKargoAgentManifestsDataSource{}
+
+func NewAkpKargoAgentManifestsDataSource() datasource.DataSource {
+	return &AkpKargoAgentManifestsDataSource{}
+}
+
+// AkpKargoAgentManifestsDataSource defines the data source implementation.
+type AkpKargoAgentManifestsDataSource struct {
+	akpCli *AkpCli
+}
+
+func (d *AkpKargoAgentManifestsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kargo_agent_manifests"
+}
+
+func (d *AkpKargoAgentManifestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.akpCli = akpCli
+}
+
+func (d *AkpKargoAgentManifestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading a Kargo Agent Manifests Datasource")
+	var data types.AgentManifests
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+	ctx = httpctx.SetAuthorizationHeader(ctx, d.akpCli.Cred.Scheme(), d.akpCli.Cred.Credential())
+	manifests, err := getKargoManifests(ctx, d.akpCli.KargoCli, d.akpCli.Poll, d.akpCli.OrgId, &types.KargoAgent{
+		InstanceID: data.InstanceID,
+		Name:       data.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if err := refreshAgentManifests(&data, manifests); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
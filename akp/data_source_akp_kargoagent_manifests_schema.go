@@ -0,0 +1,40 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (d *AkpKargoAgentManifestsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets the install manifests of a Kargo agent, e.g. to commit them to a GitOps repository instead of installing the agent with `kube_config`. Reading waits until the Kargo agent is reconciled, so the manifests reflect its latest spec. The manifests are stored in the state, use the ephemeral resource of the same name to keep them out of it",
+		Attributes:          getAKPKargoAgentManifestsDataSourceAttributes(),
+	}
+}
+
+func getAKPKargoAgentManifestsDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"instance_id": schema.StringAttribute{
+			MarkdownDescription: "Kargo instance ID",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Kargo agent name",
+			Required:            true,
+		},
+		"manifests": schema.StringAttribute{
+			MarkdownDescription: "Agent install manifests, as a multi-document YAML. They contain the credentials the agent connects to the Akuity Platform with",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"documents": schema.ListAttribute{
+			MarkdownDescription: "Agent install manifests, one YAML document per Kubernetes object, in the order they are listed in `manifests`",
+			Computed:            true,
+			Sensitive:           true,
+			ElementType:         types.StringType,
+		},
+	}
+}
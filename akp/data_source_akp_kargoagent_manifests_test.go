@@ -0,0 +1,35 @@
+//go:build !unit
+
+package akp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKargoAgentManifestsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccKargoAgentManifestsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.akp_kargo_agent_manifests.test", "instance_id", "5gjcg0rh8fjemhc0"),
+					resource.TestCheckResourceAttr("data.akp_kargo_agent_manifests.test", "name", "test-agent"),
+					resource.TestCheckResourceAttrSet("data.akp_kargo_agent_manifests.test", "manifests"),
+					resource.TestCheckResourceAttrSet("data.akp_kargo_agent_manifests.test", "documents.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKargoAgentManifestsDataSourceConfig = `
+data "akp_kargo_agent_manifests" "test" {
+  instance_id = "5gjcg0rh8fjemhc0"
+  name = "test-agent"
+}
+`
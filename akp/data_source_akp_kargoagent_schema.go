@@ -57,6 +57,15 @@ func getAKPKargoAgentDataSourceAttributes() map[string]schema.Attribute {
 			MarkdownDescription: "Whether to remove agent resources on destroy",
 			Computed:            true,
 		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "Workspace of the Kargo instance the agent belongs to",
+			Computed:            true,
+		},
+		"timeouts": schema.SingleNestedAttribute{
+			MarkdownDescription: "Timeouts for the Kargo agent operations",
+			Computed:            true,
+			Attributes:          getTimeoutsDataSourceAttributes(),
+		},
 	}
 }
 
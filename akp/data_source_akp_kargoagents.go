@@ -5,10 +5,10 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
-	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
 	"github.com/akuity/terraform-provider-akp/akp/types"
 )
@@ -58,28 +58,15 @@ func (a *AkpKargoAgentsDataSource) Read(ctx context.Context, req datasource.Read
 	}
 	ctx = httpctx.SetAuthorizationHeader(ctx, a.akpCli.Cred.Scheme(), a.akpCli.Cred.Credential())
 
-	workspaces, err := a.akpCli.OrgCli.ListWorkspaces(ctx, &orgcv1.ListWorkspacesRequest{
-		OrganizationId: a.akpCli.OrgId,
-	})
+	workspace, err := getKargoInstanceWorkspace(ctx, a.akpCli.KargoCli, a.akpCli.OrgCli, a.akpCli.OrgId, data.InstanceID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspaces, got error: %s", err))
-		return
-	}
-	var workspaceId string
-	for _, w := range workspaces.GetWorkspaces() {
-		if w.GetIsDefault() {
-			workspaceId = w.GetId()
-			break
-		}
-	}
-	if workspaceId == "" {
-		resp.Diagnostics.AddError("Client Error", "No default workspace found")
+		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
 	apiReq := &kargov1.ListKargoInstanceAgentsRequest{
 		OrganizationId: a.akpCli.OrgId,
 		InstanceId:     data.InstanceID.ValueString(),
-		WorkspaceId:    workspaceId,
+		WorkspaceId:    workspace.GetId(),
 	}
 	tflog.Debug(ctx, fmt.Sprintf("List Kargo agents request: %s", apiReq))
 	apiResp, err := a.akpCli.KargoCli.ListKargoInstanceAgents(ctx, apiReq)
@@ -96,6 +83,7 @@ func (a *AkpKargoAgentsDataSource) Read(ctx context.Context, req datasource.Read
 			InstanceID: data.InstanceID,
 		}
 		stateAgent.Update(ctx, &resp.Diagnostics, agent, nil)
+		stateAgent.Workspace = tftypes.StringValue(workspace.GetName())
 		data.Agents = append(data.Agents, stateAgent)
 	}
 	// Save data into Terraform state
@@ -0,0 +1,36 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *AkpOrganizationMemberDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets information about a member of the organization, or a pending invitation, by email",
+		Attributes:          getAKPOrganizationMemberDataSourceAttributes(),
+	}
+}
+
+func getAKPOrganizationMemberDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Member ID, or invitation ID while the invitation is pending",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email of the user",
+			Required:            true,
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "Role of the user in the organization",
+			Computed:            true,
+		},
+		"pending": schema.BoolAttribute{
+			MarkdownDescription: "Whether the user has not accepted the invitation yet",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *AkpTeamMemberDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets information about a member of a team by email",
+		Attributes:          getAKPTeamMemberDataSourceAttributes(),
+	}
+}
+
+func getAKPTeamMemberDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Team member ID, in the format `team/email`",
+			Computed:            true,
+		},
+		"team": schema.StringAttribute{
+			MarkdownDescription: "Team name",
+			Required:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email of the user",
+			Required:            true,
+		},
+		"user_id": schema.StringAttribute{
+			MarkdownDescription: "User ID of the team member",
+			Computed:            true,
+		},
+	}
+}
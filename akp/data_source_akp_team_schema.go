@@ -0,0 +1,36 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *AkpTeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets information about a team of the organization by name",
+		Attributes:          getAKPTeamDataSourceAttributes(),
+	}
+}
+
+func getAKPTeamDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Team ID, same as the team name",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Team name",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Team description",
+			Computed:            true,
+		},
+		"member_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of members of the team",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !acc
+
+package akp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// If this test fails, a field has been added/removed to the Team related types.
+// Update the schema attribute accordingly.
+func TestNoNewTeamDataSourceFields(t *testing.T) {
+	assert.Equal(t, reflect.TypeOf(types.Team{}).NumField(), len(getAKPTeamDataSourceAttributes()))
+}
@@ -0,0 +1,35 @@
+//go:build !unit
+
+package akp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamDataSource(t *testing.T) {
+	name := fmt.Sprintf("team-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccTeamResourceConfig(name, "test") + testAccTeamDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.akp_team.test", "id", name),
+					resource.TestCheckResourceAttr("data.akp_team.test", "description", "test"),
+					resource.TestCheckResourceAttr("data.akp_team.test", "member_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamDataSourceConfig = `
+data "akp_team" "test" {
+  name = akp_team.test.name
+}
+`
@@ -0,0 +1,100 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &AkpWorkspacesDataSource{}
+
+func NewAkpWorkspacesDataSource() datasource.DataSource {
+	return &AkpWorkspacesDataSource{}
+}
+
+// AkpWorkspacesDataSource defines the data source implementation.
+type AkpWorkspacesDataSource struct {
+	akpCli *AkpCli
+}
+
+func (d *AkpWorkspacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspaces"
+}
+
+func (d *AkpWorkspacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.akpCli = akpCli
+}
+
+func (d *AkpWorkspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading a Workspaces Datasource")
+	var data types.Workspaces
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+			return
+		}
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, d.akpCli.Cred.Scheme(), d.akpCli.Cred.Credential())
+
+	apiResp, err := d.akpCli.OrgCli.ListWorkspaces(ctx, &orgcv1.ListWorkspacesRequest{
+		OrganizationId: d.akpCli.OrgId,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspaces, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("List workspaces response: %s", apiResp))
+
+	data.ID = tftypes.StringValue(d.akpCli.OrgId)
+	data.Workspaces = nil
+	for _, workspace := range apiResp.GetWorkspaces() {
+		if nameRegex != nil && !nameRegex.MatchString(workspace.GetName()) {
+			continue
+		}
+		members, err := listWorkspaceMembers(ctx, d.akpCli.OrgCli, d.akpCli.OrgId, workspace.GetId())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read members of workspace %q, got error: %s", workspace.GetName(), err))
+			return
+		}
+		var stateWorkspace types.Workspace
+		stateWorkspace.Update(workspace)
+		stateWorkspace.UpdateMembers(members)
+		data.Workspaces = append(data.Workspaces, stateWorkspace)
+	}
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
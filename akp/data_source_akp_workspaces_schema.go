@@ -0,0 +1,80 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *AkpWorkspacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets information about all workspaces in the organization",
+		Attributes:          getAKPWorkspacesDataSourceAttributes(),
+	}
+}
+
+func getAKPWorkspacesDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Organization ID",
+			Computed:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: "Only return the workspaces whose name matches this regular expression, e.g. `^team-`",
+			Optional:            true,
+		},
+		"workspaces": schema.ListNestedAttribute{
+			MarkdownDescription: "List of workspaces",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: getAKPWorkspaceDataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func getAKPWorkspaceDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Workspace ID",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Workspace name",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Workspace description",
+			Computed:            true,
+		},
+		"is_default": schema.BoolAttribute{
+			MarkdownDescription: "Whether this is the default workspace of the organization",
+			Computed:            true,
+		},
+		"members": schema.SetNestedAttribute{
+			MarkdownDescription: "Users and teams that are members of the workspace",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: getAKPWorkspaceMemberDataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func getAKPWorkspaceMemberDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"user_email": schema.StringAttribute{
+			MarkdownDescription: "Email of the user, if the member is a user",
+			Computed:            true,
+		},
+		"team_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the team, if the member is a team",
+			Computed:            true,
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "Role of the member in the workspace, one of `member` or `admin`",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,20 @@
+//go:build !acc
+
+package akp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// If this test fails, a field has been added/removed to the Workspaces related types.
+// Update the schema attribute accordingly.
+func TestNoNewWorkspacesDataSourceFields(t *testing.T) {
+	assert.Equal(t, reflect.TypeOf(types.Workspaces{}).NumField(), len(getAKPWorkspacesDataSourceAttributes()))
+	assert.Equal(t, reflect.TypeOf(types.Workspace{}).NumField(), len(getAKPWorkspaceDataSourceAttributes()))
+	assert.Equal(t, reflect.TypeOf(types.WorkspaceMember{}).NumField(), len(getAKPWorkspaceMemberDataSourceAttributes()))
+}
@@ -0,0 +1,32 @@
+//go:build !unit
+
+package akp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkspacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccWorkspacesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.akp_workspaces.test", "workspaces.#", "1"),
+					resource.TestCheckResourceAttr("data.akp_workspaces.test", "workspaces.0.name", "default"),
+					resource.TestCheckResourceAttr("data.akp_workspaces.test", "workspaces.0.is_default", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWorkspacesDataSourceConfig = `
+data "akp_workspaces" "test" {
+  name_regex = "^default$"
+}
+`
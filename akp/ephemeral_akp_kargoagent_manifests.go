@@ -0,0 +1,75 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResource = &AkpKargoAgentManifestsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AkpKargoAgentManifestsEphemeralResource{}
+
+func NewAkpKargoAgentManifestsEphemeralResource() ephemeral.EphemeralResource {
+	return &AkpKargoAgentManifestsEphemeralResource{}
+}
+
+// AkpKargoAgentManifestsEphemeralResource defines the ephemeral resource implementation.
+// Unlike the data source of the same name, the manifests are never stored in the plan or the state.
+type AkpKargoAgentManifestsEphemeralResource struct {
+	akpCli *AkpCli
+}
+
+func (e *AkpKargoAgentManifestsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kargo_agent_manifests"
+}
+
+func (e *AkpKargoAgentManifestsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	e.akpCli = akpCli
+}
+
+func (e *AkpKargoAgentManifestsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Debug(ctx, "Opening a Kargo Agent Manifests Ephemeral Resource")
+	var data types.AgentManifests
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+	ctx = httpctx.SetAuthorizationHeader(ctx, e.akpCli.Cred.Scheme(), e.akpCli.Cred.Credential())
+	manifests, err := getKargoManifests(ctx, e.akpCli.KargoCli, e.akpCli.Poll, e.akpCli.OrgId, &types.KargoAgent{
+		InstanceID: data.InstanceID,
+		Name:       data.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if err := refreshAgentManifests(&data, manifests); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (e *AkpKargoAgentManifestsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets the install manifests of a Kargo agent without storing them in the plan or the state, e.g. to install the agent with the `kubernetes` or `helm` provider. Requires Terraform 1.10 or later. Opening waits until the Kargo agent is reconciled, so the manifests reflect its latest spec",
+		Attributes:          getAKPKargoAgentManifestsEphemeralAttributes(),
+	}
+}
+
+func getAKPKargoAgentManifestsEphemeralAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"instance_id": schema.StringAttribute{
+			MarkdownDescription: "Kargo instance ID",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Kargo agent name",
+			Required:            true,
+		},
+		"manifests": schema.StringAttribute{
+			MarkdownDescription: "Agent install manifests, as a multi-document YAML. They contain the credentials the agent connects to the Akuity Platform with",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"documents": schema.ListAttribute{
+			MarkdownDescription: "Agent install manifests, one YAML document per Kubernetes object, in the order they are listed in `manifests`",
+			Computed:            true,
+			Sensitive:           true,
+			ElementType:         types.StringType,
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+)
+
+const (
+	importIDPrefix   = "id:"
+	importNamePrefix = "name:"
+)
+
+// parseInstanceImportID parses the identifier an instance is imported with: `id:<id>`, `name:<name>`, or a bare name or ID.
+// explicit is false for a bare identifier, in which case it is looked up as a name first, then as an ID.
+func parseInstanceImportID(importID string) (idType idv1.Type, id string, explicit bool, err error) {
+	switch {
+	case strings.HasPrefix(importID, importIDPrefix):
+		idType, id, explicit = idv1.Type_ID, strings.TrimPrefix(importID, importIDPrefix), true
+	case strings.HasPrefix(importID, importNamePrefix):
+		idType, id, explicit = idv1.Type_NAME, strings.TrimPrefix(importID, importNamePrefix), true
+	default:
+		idType, id = idv1.Type_NAME, importID
+	}
+	if id == "" {
+		return idType, id, explicit, fmt.Errorf("Expected import identifier with format: id:<id>, name:<name> or <name or id>. Got: %q", importID)
+	}
+	return idType, id, explicit, nil
+}
+
+// resolveInstanceImport looks up the Argo CD instance to import.
+func resolveInstanceImport(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, orgID, importID string) (*argocdv1.Instance, error) {
+	idType, id, explicit, err := parseInstanceImportID(importID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetInstance(ctx, &argocdv1.GetInstanceRequest{
+		OrganizationId: orgID,
+		IdType:         idType,
+		Id:             id,
+	})
+	if !explicit && status.Code(err) == codes.NotFound {
+		resp, err = client.GetInstance(ctx, &argocdv1.GetInstanceRequest{
+			OrganizationId: orgID,
+			IdType:         idv1.Type_ID,
+			Id:             id,
+		})
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to read Argo CD instance %q", id)
+	}
+	return resp.GetInstance(), nil
+}
+
+// resolveKargoInstanceImport looks up the Kargo instance to import. Kargo instances can only be read by name,
+// so instances imported by ID are looked up in the list of instances of the organization.
+func resolveKargoInstanceImport(ctx context.Context, client kargov1.KargoServiceGatewayClient, orgID, importID string) (*kargov1.KargoInstance, error) {
+	idType, id, explicit, err := parseInstanceImportID(importID)
+	if err != nil {
+		return nil, err
+	}
+	if idType == idv1.Type_NAME {
+		resp, err := client.GetKargoInstance(ctx, &kargov1.GetKargoInstanceRequest{
+			OrganizationId: orgID,
+			Name:           id,
+		})
+		if err == nil {
+			return resp.GetInstance(), nil
+		}
+		if explicit || status.Code(err) != codes.NotFound {
+			return nil, errors.Wrapf(err, "Unable to read Kargo instance %q", id)
+		}
+	}
+	instances, err := client.ListKargoInstances(ctx, &kargov1.ListKargoInstancesRequest{
+		OrganizationId: orgID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read Kargo instances")
+	}
+	for _, instance := range instances.GetInstances() {
+		if instance.GetId() == id {
+			return instance, nil
+		}
+	}
+	return nil, fmt.Errorf("Kargo instance %q not found", id)
+}
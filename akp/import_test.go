@@ -0,0 +1,175 @@
+package akp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuity/api-client-go/pkg/api/gateway/accesscontrol"
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+)
+
+type fakeKargoClient struct {
+	kargov1.KargoServiceGatewayClient
+	instances []*kargov1.KargoInstance
+}
+
+func (c *fakeKargoClient) GetKargoInstance(ctx context.Context, req *kargov1.GetKargoInstanceRequest) (*kargov1.GetKargoInstanceResponse, error) {
+	for _, instance := range c.instances {
+		if instance.GetName() == req.GetName() {
+			return &kargov1.GetKargoInstanceResponse{Instance: instance}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (c *fakeKargoClient) ListKargoInstances(ctx context.Context, req *kargov1.ListKargoInstancesRequest) (*kargov1.ListKargoInstancesResponse, error) {
+	return &kargov1.ListKargoInstancesResponse{Instances: c.instances}, nil
+}
+
+func TestParseInstanceImportID(t *testing.T) {
+	tests := []struct {
+		importID     string
+		wantType     idv1.Type
+		wantID       string
+		wantExplicit bool
+		wantErr      bool
+	}{
+		{importID: "id:abc123", wantType: idv1.Type_ID, wantID: "abc123", wantExplicit: true},
+		{importID: "name:my-instance", wantType: idv1.Type_NAME, wantID: "my-instance", wantExplicit: true},
+		{importID: "my-instance", wantType: idv1.Type_NAME, wantID: "my-instance"},
+		{importID: "id:", wantErr: true},
+		{importID: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			idType, id, explicit, err := parseInstanceImportID(tt.importID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantType, idType)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantExplicit, explicit)
+		})
+	}
+}
+
+func TestResolveInstanceImport(t *testing.T) {
+	instance := &argocdv1.Instance{Id: "abc123", Name: "my-instance"}
+	client := &fakeArgoCDClient{
+		getInstance: func(ctx context.Context, req *argocdv1.GetInstanceRequest) (*argocdv1.GetInstanceResponse, error) {
+			if (req.GetIdType() == idv1.Type_ID && req.GetId() == instance.GetId()) || (req.GetIdType() == idv1.Type_NAME && req.GetId() == instance.GetName()) {
+				return &argocdv1.GetInstanceResponse{Instance: instance}, nil
+			}
+			return nil, status.Error(codes.NotFound, "not found")
+		},
+	}
+	for _, importID := range []string{"id:abc123", "name:my-instance", "my-instance", "abc123"} {
+		t.Run(importID, func(t *testing.T) {
+			got, err := resolveInstanceImport(context.Background(), client, "org", importID)
+			assert.NoError(t, err)
+			assert.Equal(t, instance, got)
+		})
+	}
+	_, err := resolveInstanceImport(context.Background(), client, "org", "name:abc123")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestResolveKargoInstanceImport(t *testing.T) {
+	instance := &kargov1.KargoInstance{Id: "abc123", Name: "my-kargo"}
+	client := &fakeKargoClient{instances: []*kargov1.KargoInstance{instance}}
+	for _, importID := range []string{"id:abc123", "name:my-kargo", "my-kargo", "abc123"} {
+		t.Run(importID, func(t *testing.T) {
+			got, err := resolveKargoInstanceImport(context.Background(), client, "org", importID)
+			assert.NoError(t, err)
+			assert.Equal(t, instance, got)
+		})
+	}
+	_, err := resolveKargoInstanceImport(context.Background(), client, "org", "name:abc123")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = resolveKargoInstanceImport(context.Background(), client, "org", "id:my-kargo")
+	assert.EqualError(t, err, `Kargo instance "my-kargo" not found`)
+}
+
+// importState imports the resource with the import ID into an empty state and returns the instance_id and name it set.
+func importState(t *testing.T, r resource.ResourceWithImportState, importID string) (instanceID, name string, resp *resource.ImportStateResponse) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	resp = &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: importID}, resp)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("instance_id"), &instanceID)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	}
+	return instanceID, name, resp
+}
+
+func TestAkpClusterResource_ImportState(t *testing.T) {
+	instance := &argocdv1.Instance{Id: "abc123", Name: "my-instance"}
+	r := &AkpClusterResource{akpCli: &AkpCli{
+		Cred:  accesscontrol.NewAPIKeyCredential("id", "secret"),
+		OrgId: "org",
+		Cli: &fakeArgoCDClient{
+			getInstance: func(ctx context.Context, req *argocdv1.GetInstanceRequest) (*argocdv1.GetInstanceResponse, error) {
+				if (req.GetIdType() == idv1.Type_ID && req.GetId() == instance.GetId()) || (req.GetIdType() == idv1.Type_NAME && req.GetId() == instance.GetName()) {
+					return &argocdv1.GetInstanceResponse{Instance: instance}, nil
+				}
+				return nil, status.Error(codes.NotFound, "not found")
+			},
+		},
+	}}
+	for _, importID := range []string{"my-instance/my-cluster", "name:my-instance/my-cluster", "abc123/my-cluster", "id:abc123/my-cluster"} {
+		t.Run(importID, func(t *testing.T) {
+			instanceID, name, resp := importState(t, r, importID)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, "abc123", instanceID)
+			assert.Equal(t, "my-cluster", name)
+		})
+	}
+	for _, importID := range []string{"my-cluster", "my-instance/", "/my-cluster", "a/b/c", "other-instance/my-cluster"} {
+		t.Run(importID, func(t *testing.T) {
+			_, _, resp := importState(t, r, importID)
+			assert.True(t, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestAkpKargoAgentResource_ImportState(t *testing.T) {
+	instance := &kargov1.KargoInstance{Id: "abc123", Name: "my-kargo"}
+	r := &AkpKargoAgentResource{akpCli: &AkpCli{
+		Cred:     accesscontrol.NewAPIKeyCredential("id", "secret"),
+		OrgId:    "org",
+		KargoCli: &fakeKargoClient{instances: []*kargov1.KargoInstance{instance}},
+	}}
+	for _, importID := range []string{"my-kargo/my-agent", "name:my-kargo/my-agent", "abc123/my-agent", "id:abc123/my-agent"} {
+		t.Run(importID, func(t *testing.T) {
+			instanceID, name, resp := importState(t, r, importID)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, "abc123", instanceID)
+			assert.Equal(t, "my-agent", name)
+		})
+	}
+	for _, importID := range []string{"my-agent", "my-kargo/", "/my-agent", "a/b/c", "other-kargo/my-agent"} {
+		t.Run(importID, func(t *testing.T) {
+			_, _, resp := importState(t, r, importID)
+			assert.True(t, resp.Diagnostics.HasError())
+		})
+	}
+}
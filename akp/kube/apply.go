@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
@@ -18,14 +21,28 @@ import (
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 )
 
+// DefaultFieldManager is the field manager of server-side applies when none is configured.
+const DefaultFieldManager = "terraform-provider-akp"
+
 type ApplyOpts struct {
 	DryRunStrategy cmdutil.DryRunStrategy
 	Force          bool
 	Validate       bool
+	// ServerSide applies the resource server-side instead of client-side, owning its fields as FieldManager.
+	ServerSide     bool
+	FieldManager   string
+	ForceConflicts bool
 }
 
-// ApplyResource performs an apply of a unstructured resource
+// ApplyResource performs an apply of a unstructured resource. Server-side applies fall back to a client-side apply
+// when the cluster does not support them.
 func (k *Kubectl) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, applyOpts ApplyOpts) (string, error) {
+	if applyOpts.ServerSide {
+		msg, err := k.serverSideApply(ctx, obj, applyOpts)
+		if !apierrors.IsUnsupportedMediaType(err) {
+			return msg, err
+		}
+	}
 	objBytes, err := json.Marshal(obj)
 	if err != nil {
 		return "", err
@@ -35,15 +52,24 @@ func (k *Kubectl) ApplyResource(ctx context.Context, obj *unstructured.Unstructu
 		Out:    &bytes.Buffer{},
 		ErrOut: &bytes.Buffer{},
 	}
-	path, err := writeFile(objBytes)
+	kubeApplyOpts, err := k.newApplyOptions(ioStreams, obj, applyOpts)
 	if err != nil {
 		return "", err
 	}
-	defer deleteFile(path)
-	kubeApplyOpts, err := k.newApplyOptions(ioStreams, obj, path, applyOpts)
+	// Hand the object over in memory, the builder would otherwise read it from a file.
+	infos, err := kubeApplyOpts.Builder.
+		Unstructured().
+		Schema(kubeApplyOpts.Validator).
+		ContinueOnError().
+		NamespaceParam(kubeApplyOpts.Namespace).DefaultNamespace().
+		Stream(bytes.NewReader(objBytes), obj.GetName()).
+		Flatten().
+		Do().
+		Infos()
 	if err != nil {
 		return "", err
 	}
+	kubeApplyOpts.SetObjects(infos)
 	applyErr := kubeApplyOpts.Run()
 	var out []string
 	if buf := strings.TrimSpace(ioStreams.Out.(*bytes.Buffer).String()); len(buf) > 0 {
@@ -55,7 +81,52 @@ func (k *Kubectl) ApplyResource(ctx context.Context, obj *unstructured.Unstructu
 	return strings.Join(out, ". "), applyErr
 }
 
-func (k *Kubectl) newApplyOptions(ioStreams genericclioptions.IOStreams, obj *unstructured.Unstructured, path string, applyOpts ApplyOpts) (*apply.ApplyOptions, error) {
+// serverSideApply applies the resource with an apply patch through the dynamic client.
+func (k *Kubectl) serverSideApply(ctx context.Context, obj *unstructured.Unstructured, applyOpts ApplyOpts) (string, error) {
+	objBytes, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	mapper, err := k.fact.ToRESTMapper()
+	if err != nil {
+		return "", err
+	}
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return "", fmt.Errorf("failed to map %s: %w", gvk, err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(k.config)
+	if err != nil {
+		return "", err
+	}
+	var client dynamic.ResourceInterface = dynamicClient.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		namespace := obj.GetNamespace()
+		if namespace == "" {
+			namespace = metav1.NamespaceDefault
+		}
+		client = dynamicClient.Resource(mapping.Resource).Namespace(namespace)
+	}
+
+	fieldManager := applyOpts.FieldManager
+	if fieldManager == "" {
+		fieldManager = DefaultFieldManager
+	}
+	patchOpts := metav1.PatchOptions{
+		FieldManager: fieldManager,
+		Force:        &applyOpts.ForceConflicts,
+	}
+	if applyOpts.DryRunStrategy == cmdutil.DryRunServer {
+		patchOpts.DryRun = []string{metav1.DryRunAll}
+	}
+	if _, err = client.Patch(ctx, obj.GetName(), types.ApplyPatchType, objBytes, patchOpts); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s serverside-applied", strings.ToLower(mapping.GroupVersionKind.Kind), obj.GetName()), nil
+}
+
+func (k *Kubectl) newApplyOptions(ioStreams genericclioptions.IOStreams, obj *unstructured.Unstructured, applyOpts ApplyOpts) (*apply.ApplyOptions, error) {
 	flags := apply.NewApplyFlags(ioStreams)
 	o := &apply.ApplyOptions{
 		IOStreams:         ioStreams,
@@ -107,7 +178,6 @@ func (k *Kubectl) newApplyOptions(ioStreams genericclioptions.IOStreams, obj *un
 		}
 		return o.PrintFlags.ToPrinter()
 	}
-	o.DeleteOptions.FilenameOptions.Filenames = []string{path}
 	o.Namespace = obj.GetNamespace()
 	o.DeleteOptions.ForceDeletion = applyOpts.Force
 	o.DryRunStrategy = applyOpts.DryRunStrategy
@@ -2,6 +2,9 @@ package kube
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"sync"
 
 	"github.com/mitchellh/go-homedir"
 	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -17,9 +20,11 @@ import (
 )
 
 type Kubectl struct {
-	config        *rest.Config
-	fact          cmdutil.Factory
-	openAPISchema openapi.Resources
+	config *rest.Config
+	fact   cmdutil.Factory
+	// openAPISchemaMu guards openAPISchema, resources are applied concurrently.
+	openAPISchemaMu sync.Mutex
+	openAPISchema   openapi.Resources
 }
 
 // NewKubectl returns a kubectl instance from a rest config
@@ -64,7 +69,9 @@ func InitializeConfiguration(k *types.Kubeconfig) (*rest.Config, error) {
 		} else {
 			loader.Precedence = expandedPaths
 		}
-
+	}
+	rawConfig := k.ConfigRaw.ValueString()
+	if len(configPaths) > 0 || rawConfig != "" {
 		ctxSuffix := "; default context"
 
 		kubectx := k.ConfigContext.ValueString()
@@ -128,8 +135,35 @@ func InitializeConfiguration(k *types.Kubeconfig) (*rest.Config, error) {
 	if v := k.ProxyUrl.ValueString(); v != "" {
 		overrides.ClusterDefaults.ProxyURL = v
 	}
+	if v := k.TLSServerName.ValueString(); v != "" {
+		overrides.ClusterInfo.TLSServerName = v
+	}
+	if k.Exec != nil {
+		exec := &clientcmdapi.ExecConfig{
+			APIVersion:      k.Exec.APIVersion.ValueString(),
+			Command:         k.Exec.Command.ValueString(),
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		}
+		for _, arg := range k.Exec.Args {
+			exec.Args = append(exec.Args, arg.ValueString())
+		}
+		for _, name := range slices.Sorted(maps.Keys(k.Exec.Env)) {
+			exec.Env = append(exec.Env, clientcmdapi.ExecEnvVar{Name: name, Value: k.Exec.Env[name].ValueString()})
+		}
+		overrides.AuthInfo.Exec = exec
+	}
 
-	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
+	var cc clientcmd.ClientConfig
+	if rawConfig != "" {
+		// Same as clientcmd.RESTConfigFromKubeConfig, but with the overrides.
+		config, err := clientcmd.Load([]byte(rawConfig))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse raw kube config: %s", err)
+		}
+		cc = clientcmd.NewNonInteractiveClientConfig(*config, overrides.CurrentContext, overrides, nil)
+	} else {
+		cc = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
+	}
 	cfg, err := cc.ClientConfig()
 	if err != nil {
 		return nil, fmt.Errorf("Invalid provider configuration: %s", err)
@@ -144,6 +178,8 @@ func InitializeConfiguration(k *types.Kubeconfig) (*rest.Config, error) {
 }
 
 func (k *Kubectl) OpenAPISchema() (openapi.Resources, error) {
+	k.openAPISchemaMu.Lock()
+	defer k.openAPISchemaMu.Unlock()
 	if k.openAPISchema != nil {
 		return k.openAPISchema, nil
 	}
@@ -0,0 +1,100 @@
+package kube
+
+import (
+	"testing"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+func TestInitializeConfiguration_exec(t *testing.T) {
+	cfg, err := InitializeConfiguration(&types.Kubeconfig{
+		Host:                 tftypes.StringValue("https://10.0.0.1"),
+		ClusterCaCertificate: tftypes.StringValue("ca"),
+		TLSServerName:        tftypes.StringValue("kubernetes.default.svc"),
+		Exec: &types.KubeconfigExec{
+			APIVersion: tftypes.StringValue("client.authentication.k8s.io/v1beta1"),
+			Command:    tftypes.StringValue("aws"),
+			Args: []tftypes.String{
+				tftypes.StringValue("eks"),
+				tftypes.StringValue("get-token"),
+				tftypes.StringValue("--cluster-name"),
+				tftypes.StringValue("my-cluster"),
+			},
+			Env: map[string]tftypes.String{
+				"AWS_REGION":  tftypes.StringValue("us-west-2"),
+				"AWS_PROFILE": tftypes.StringValue("admin"),
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1", cfg.Host)
+	assert.Equal(t, "kubernetes.default.svc", cfg.TLSClientConfig.ServerName)
+	assert.Equal(t, &clientcmdapi.ExecConfig{
+		APIVersion: "client.authentication.k8s.io/v1beta1",
+		Command:    "aws",
+		Args:       []string{"eks", "get-token", "--cluster-name", "my-cluster"},
+		Env: []clientcmdapi.ExecEnvVar{
+			{Name: "AWS_PROFILE", Value: "admin"},
+			{Name: "AWS_REGION", Value: "us-west-2"},
+		},
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}, cfg.ExecProvider)
+}
+
+func TestInitializeConfiguration_raw(t *testing.T) {
+	raw := `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster:
+    server: https://dev.example.com
+- name: prod
+  cluster:
+    server: https://prod.example.com
+users:
+- name: dev
+  user:
+    token: dev-token
+- name: prod
+  user:
+    token: prod-token
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    user: dev
+- name: prod
+  context:
+    cluster: prod
+    user: prod
+`
+	cfg, err := InitializeConfiguration(&types.Kubeconfig{ConfigRaw: tftypes.StringValue(raw)})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://dev.example.com", cfg.Host)
+	assert.Equal(t, "dev-token", cfg.BearerToken)
+
+	cfg, err = InitializeConfiguration(&types.Kubeconfig{
+		ConfigRaw:     tftypes.StringValue(raw),
+		ConfigContext: tftypes.StringValue("prod"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com", cfg.Host)
+	assert.Equal(t, "prod-token", cfg.BearerToken)
+
+	cfg, err = InitializeConfiguration(&types.Kubeconfig{
+		ConfigRaw:             tftypes.StringValue(raw),
+		ConfigContextCluster:  tftypes.StringValue("prod"),
+		ConfigContextAuthInfo: tftypes.StringValue("dev"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com", cfg.Host)
+	assert.Equal(t, "dev-token", cfg.BearerToken)
+
+	_, err = InitializeConfiguration(&types.Kubeconfig{ConfigRaw: tftypes.StringValue("not: [a kubeconfig")})
+	assert.ErrorContains(t, err, "Failed to parse raw kube config")
+}
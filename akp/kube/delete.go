@@ -8,7 +8,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
-	"k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/kubectl/pkg/cmd/delete"
 	"k8s.io/kubectl/pkg/cmd/util"
@@ -31,17 +30,7 @@ func (k *Kubectl) DeleteResource(ctx context.Context, obj *unstructured.Unstruct
 		Out:    &bytes.Buffer{},
 		ErrOut: &bytes.Buffer{},
 	}
-	path, err := writeFile(objBytes)
-	if err != nil {
-		return "", err
-	}
-	defer deleteFile(path)
-	dcmd := delete.NewCmdDelete(k.fact, ioStreams)
-	kubeDeleteOpts, err := k.newDeleteOptions(ioStreams, obj, path, deleteOpts)
-	if err != nil {
-		return "", err
-	}
-	err = kubeDeleteOpts.Complete(k.fact,[]string{}, dcmd)
+	kubeDeleteOpts, err := k.newDeleteOptions(ioStreams, obj, objBytes, deleteOpts)
 	if err != nil {
 		return "", err
 	}
@@ -52,23 +41,39 @@ func (k *Kubectl) DeleteResource(ctx context.Context, obj *unstructured.Unstruct
 	return "", nil
 }
 
-func (k *Kubectl) newDeleteOptions(ioStreams genericclioptions.IOStreams, obj *unstructured.Unstructured, path string, deleteOpts DeleteOpts)  (*delete.DeleteOptions, error){
+func (k *Kubectl) newDeleteOptions(ioStreams genericclioptions.IOStreams, obj *unstructured.Unstructured, objBytes []byte, deleteOpts DeleteOpts) (*delete.DeleteOptions, error) {
 	o := &delete.DeleteOptions{
-		FilenameOptions:  resource.FilenameOptions{
-			Filenames: []string{path},
-		},
-		IgnoreNotFound:   deleteOpts.IgnoreNotFound,
-		WaitForDeletion:  deleteOpts.WaitForDeletion,
-		GracePeriod:      deleteOpts.GracePeriod,
-		Output:           "name",
-		IOStreams:        ioStreams,
+		IgnoreNotFound:    deleteOpts.IgnoreNotFound,
+		WaitForDeletion:   deleteOpts.WaitForDeletion,
+		GracePeriod:       deleteOpts.GracePeriod,
+		Output:            "name",
+		IOStreams:         ioStreams,
 		CascadingStrategy: metav1.DeletePropagationBackground,
 	}
+	// Same as kubectl delete without --force, a grace period of 0 is turned into 1.
+	if o.GracePeriod == 0 {
+		o.GracePeriod = 1
+	}
 	dynamicClient, err := dynamic.NewForConfig(k.config)
 	if err != nil {
 		return nil, err
 	}
 	o.DynamicClient = dynamicClient
 	o.DryRunStrategy = util.DryRunNone
-	return o,nil
+	o.Mapper, err = k.fact.ToRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+	// Hand the object over in memory, the builder would otherwise read it from a file.
+	o.Result = k.fact.NewBuilder().
+		Unstructured().
+		ContinueOnError().
+		NamespaceParam(obj.GetNamespace()).DefaultNamespace().
+		Stream(bytes.NewReader(objBytes), obj.GetName()).
+		Flatten().
+		Do()
+	if err := o.Result.Err(); err != nil {
+		return nil, err
+	}
+	return o, nil
 }
@@ -0,0 +1,145 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	inventoryKey             = "objects"
+	inventoryManagedBy       = "terraform-provider-akp"
+	inventoryComponent       = "inventory"
+	inventoryOwnerAnnotation = "terraform-provider-akp/owner"
+)
+
+// Inventory is the ConfigMap that records the objects applied to a cluster, so that the objects dropped from the manifests
+// of a later apply can be pruned.
+type Inventory struct {
+	Namespace string
+	Name      string
+	// Owner identifies the agent the objects belong to, so that the inventory of another agent installed in the same
+	// namespace of another cluster is not mistaken for it.
+	Owner string
+}
+
+type inventoryEntry struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// ReadInventory returns the objects recorded in the inventory, as objects with only their type and metadata set.
+// An inventory that does not exist yet holds no objects.
+func (k *Kubectl) ReadInventory(ctx context.Context, inv Inventory) ([]unstructured.Unstructured, error) {
+	clientset, err := kubernetes.NewForConfig(k.config)
+	if err != nil {
+		return nil, err
+	}
+	cm, err := clientset.CoreV1().ConfigMaps(inv.Namespace).Get(ctx, inv.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read inventory %s/%s: %w", inv.Namespace, inv.Name, err)
+	}
+	var entries []inventoryEntry
+	if err := json.Unmarshal([]byte(cm.Data[inventoryKey]), &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse inventory %s/%s: %w", inv.Namespace, inv.Name, err)
+	}
+	objs := make([]unstructured.Unstructured, 0, len(entries))
+	for _, e := range entries {
+		un := unstructured.Unstructured{}
+		un.SetAPIVersion(e.APIVersion)
+		un.SetKind(e.Kind)
+		un.SetNamespace(e.Namespace)
+		un.SetName(e.Name)
+		objs = append(objs, un)
+	}
+	return objs, nil
+}
+
+// ReadInventoryOwner returns the owner recorded in the inventory, or an empty string if the inventory does not exist or
+// was written without an owner.
+func (k *Kubectl) ReadInventoryOwner(ctx context.Context, inv Inventory) (string, error) {
+	clientset, err := kubernetes.NewForConfig(k.config)
+	if err != nil {
+		return "", err
+	}
+	cm, err := clientset.CoreV1().ConfigMaps(inv.Namespace).Get(ctx, inv.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read inventory %s/%s: %w", inv.Namespace, inv.Name, err)
+	}
+	return cm.Annotations[inventoryOwnerAnnotation], nil
+}
+
+// WriteInventory records the objects in the inventory, replacing the ones recorded before.
+func (k *Kubectl) WriteInventory(ctx context.Context, inv Inventory, objs []unstructured.Unstructured) error {
+	clientset, err := kubernetes.NewForConfig(k.config)
+	if err != nil {
+		return err
+	}
+	entries := make([]inventoryEntry, 0, len(objs))
+	for _, un := range objs {
+		entries = append(entries, inventoryEntry{
+			APIVersion: un.GetAPIVersion(),
+			Kind:       un.GetKind(),
+			Namespace:  un.GetNamespace(),
+			Name:       un.GetName(),
+		})
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      inv.Name,
+			Namespace: inv.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": inventoryManagedBy,
+				"app.kubernetes.io/component":  inventoryComponent,
+			},
+			Annotations: map[string]string{
+				inventoryOwnerAnnotation: inv.Owner,
+			},
+		},
+		Data: map[string]string{inventoryKey: string(data)},
+	}
+	configMaps := clientset.CoreV1().ConfigMaps(inv.Namespace)
+	existing, err := configMaps.Get(ctx, inv.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	case err == nil:
+		cm.ResourceVersion = existing.ResourceVersion
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write inventory %s/%s: %w", inv.Namespace, inv.Name, err)
+	}
+	return nil
+}
+
+// DeleteInventory deletes the inventory, if it exists.
+func (k *Kubectl) DeleteInventory(ctx context.Context, inv Inventory) error {
+	clientset, err := kubernetes.NewForConfig(k.config)
+	if err != nil {
+		return err
+	}
+	err = clientset.CoreV1().ConfigMaps(inv.Namespace).Delete(ctx, inv.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete inventory %s/%s: %w", inv.Namespace, inv.Name, err)
+	}
+	return nil
+}
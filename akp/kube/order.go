@@ -0,0 +1,93 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+)
+
+var crdResource = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// crdEstablishedInterval is how often WaitForEstablished checks whether the CRDs are established.
+var crdEstablishedInterval = time.Second
+
+// Tiers groups objects into the order they have to be applied in: namespaces and CRDs, then service accounts and RBAC,
+// then everything else. Objects within a tier do not depend on each other and keep their relative order.
+// Deleting goes through the tiers in reverse.
+func Tiers(objs []unstructured.Unstructured) [][]unstructured.Unstructured {
+	tiers := make([][]unstructured.Unstructured, 3)
+	for _, un := range objs {
+		tier := tierOf(un)
+		tiers[tier] = append(tiers[tier], un)
+	}
+	nonEmpty := tiers[:0]
+	for _, tier := range tiers {
+		if len(tier) > 0 {
+			nonEmpty = append(nonEmpty, tier)
+		}
+	}
+	return nonEmpty
+}
+
+func tierOf(un unstructured.Unstructured) int {
+	gk := un.GroupVersionKind().GroupKind()
+	switch {
+	case gk.Group == "" && gk.Kind == "Namespace", isCRD(un):
+		return 0
+	case gk.Group == "" && gk.Kind == "ServiceAccount", gk.Group == "rbac.authorization.k8s.io":
+		return 1
+	default:
+		return 2
+	}
+}
+
+func isCRD(un unstructured.Unstructured) bool {
+	gk := un.GroupVersionKind().GroupKind()
+	return gk.Group == crdResource.Group && gk.Kind == "CustomResourceDefinition"
+}
+
+// WaitForEstablished waits until the CRDs among the objects are established, so that their custom resources can be applied.
+func (k *Kubectl) WaitForEstablished(ctx context.Context, objs []unstructured.Unstructured) error {
+	var names []string
+	for _, un := range objs {
+		if isCRD(un) {
+			names = append(names, un.GetName())
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	dynamicClient, err := dynamic.NewForConfig(k.config)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		err := wait.PollUntilContextCancel(ctx, crdEstablishedInterval, true, func(ctx context.Context) (bool, error) {
+			crd, err := dynamicClient.Resource(crdResource).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			conditions, _, err := unstructured.NestedSlice(crd.Object, "status", "conditions")
+			if err != nil {
+				return false, err
+			}
+			for _, c := range conditions {
+				condition, ok := c.(map[string]any)
+				if ok && condition["type"] == "Established" && condition["status"] == string(metav1.ConditionTrue) {
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to wait for CRD %s to be established: %w", name, err)
+		}
+	}
+	return nil
+}
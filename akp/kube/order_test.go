@@ -0,0 +1,62 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTiers(t *testing.T) {
+	objs, err := SplitYAML([]byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: akuity-agent
+  namespace: akuity
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: akuity-agent
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: akuity-agent
+  namespace: akuity
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: applications.argoproj.io
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: akuity-agent
+  namespace: akuity
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: akuity
+`))
+	assert.NoError(t, err)
+
+	var kinds [][]string
+	for _, tier := range Tiers(objs) {
+		var tierKinds []string
+		for _, un := range tier {
+			tierKinds = append(tierKinds, un.GetKind())
+		}
+		kinds = append(kinds, tierKinds)
+	}
+	assert.Equal(t, [][]string{
+		{"CustomResourceDefinition", "Namespace"},
+		{"ClusterRole", "ServiceAccount"},
+		{"Deployment", "ConfigMap"},
+	}, kinds)
+
+	// Empty tiers are skipped.
+	assert.Len(t, Tiers(objs[:1]), 1)
+	assert.Empty(t, Tiers(nil))
+}
@@ -0,0 +1,41 @@
+package akp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+)
+
+// getOrganizationMember looks up a member of the organization by email, ignoring case.
+// Invitations that have not been accepted yet are returned as well, with pending set.
+// A NotFound status error is returned when the email is neither a member nor invited.
+func getOrganizationMember(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, orgID, email string) (member *orgcv1.OrganizationMember, pending bool, err error) {
+	members, err := orgc.ListOrganizationMembers(ctx, &orgcv1.ListOrganizationMembersRequest{
+		Id: orgID,
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "Unable to read organization members")
+	}
+	for _, m := range members.GetMembers() {
+		if strings.EqualFold(m.GetEmail(), email) {
+			return m, false, nil
+		}
+	}
+	invitees, err := orgc.ListOrganizationInvitees(ctx, &orgcv1.ListOrganizationInviteesRequest{
+		Id: orgID,
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "Unable to read organization invitees")
+	}
+	for _, i := range invitees.GetInvitees() {
+		if strings.EqualFold(i.GetEmail(), email) {
+			return &orgcv1.OrganizationMember{Id: i.GetId(), Email: i.GetEmail(), Role: i.GetRole()}, true, nil
+		}
+	}
+	return nil, false, status.Errorf(codes.NotFound, "Organization member %q not found", email)
+}
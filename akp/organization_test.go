@@ -0,0 +1,64 @@
+package akp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+)
+
+func TestGetOrganizationMember(t *testing.T) {
+	client := &fakeOrgClient{
+		members: []*orgcv1.OrganizationMember{
+			{Id: "user-1", Email: "alice@example.com", Role: "admin"},
+		},
+		invitees: []*orgcv1.OrganizationInvitee{
+			{Id: "invite-1", Email: "bob@example.com", Role: "member"},
+		},
+	}
+	tests := []struct {
+		name        string
+		email       string
+		wantID      string
+		wantPending bool
+		wantCode    codes.Code
+	}{
+		{name: "member", email: "alice@example.com", wantID: "user-1"},
+		{name: "member ignoring case", email: "Alice@Example.com", wantID: "user-1"},
+		{name: "invitee", email: "bob@example.com", wantID: "invite-1", wantPending: true},
+		{name: "not found", email: "carol@example.com", wantCode: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member, pending, err := getOrganizationMember(context.Background(), client, "org", tt.email)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, member.GetId())
+			assert.Equal(t, tt.wantPending, pending)
+		})
+	}
+}
+
+func TestGetTeamMemberUserID(t *testing.T) {
+	client := &fakeOrgClient{
+		members: []*orgcv1.OrganizationMember{
+			{Id: "user-1", Email: "alice@example.com", Role: "admin"},
+		},
+		invitees: []*orgcv1.OrganizationInvitee{
+			{Id: "invite-1", Email: "bob@example.com", Role: "member"},
+		},
+	}
+	userID, err := getTeamMemberUserID(context.Background(), client, "org", "alice@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", userID)
+
+	_, err = getTeamMemberUserID(context.Background(), client, "org", "bob@example.com")
+	assert.EqualError(t, err, `User "bob@example.com" has not accepted the invitation to the organization yet`)
+}
@@ -4,36 +4,49 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/akuity/api-client-go/pkg/api/gateway/accesscontrol"
 	gwoption "github.com/akuity/api-client-go/pkg/api/gateway/option"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
 	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
 	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	akptypes "github.com/akuity/terraform-provider-akp/akp/types"
+	"github.com/akuity/terraform-provider-akp/akp/waiter"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ provider.Provider = &AkpProvider{}
+var _ provider.ProviderWithEphemeralResources = &AkpProvider{}
 
 type AkpProvider struct {
 	version string
 }
 
 type AkpProviderModel struct {
-	ServerUrl        types.String `tfsdk:"server_url"`
-	ApiKeyId         types.String `tfsdk:"api_key_id"`
-	ApiKeySecret     types.String `tfsdk:"api_key_secret"`
-	OrganizationName types.String `tfsdk:"org_name"`
-	SkipTLSVerify    types.Bool   `tfsdk:"skip_tls_verify"`
+	ServerUrl        types.String         `tfsdk:"server_url"`
+	ApiKeyId         types.String         `tfsdk:"api_key_id"`
+	ApiKeySecret     types.String         `tfsdk:"api_key_secret"`
+	OrganizationName types.String         `tfsdk:"org_name"`
+	SkipTLSVerify    types.Bool           `tfsdk:"skip_tls_verify"`
+	PollInterval     types.String         `tfsdk:"poll_interval"`
+	PollMaxInterval  types.String         `tfsdk:"poll_max_interval"`
+	MaxRetries       types.Int64          `tfsdk:"max_retries"`
+	KubeConfig       *akptypes.Kubeconfig `tfsdk:"kube_config"`
 }
 
 type AkpCli struct {
@@ -41,7 +54,14 @@ type AkpCli struct {
 	KargoCli kargov1.KargoServiceGatewayClient
 	Cred     accesscontrol.ClientCredential
 	OrgCli   orgcv1.OrganizationServiceGatewayClient
-	OrgId    string
+	// APIKeyCli manages existing API keys, new ones are created through OrgCli.
+	APIKeyCli apikeyv1.APIKeyServiceGatewayClient
+	OrgId     string
+	// Poll configures how long-running operations (health, reconciliation, deletion) are polled.
+	Poll waiter.Config
+	// KubeConfig connects to the clusters agents are removed from on destroy, the kube_config of the resources is not
+	// stored in the state.
+	KubeConfig *akptypes.Kubeconfig
 }
 
 func (p *AkpProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -74,6 +94,32 @@ func (p *AkpProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"poll_interval": schema.StringAttribute{
+				MarkdownDescription: "Initial interval between two status checks while waiting for a resource to become healthy, reconcile or be deleted, default: `1s`. The interval grows exponentially up to `poll_max_interval`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRegex, durationRegexDescription),
+				},
+			},
+			"poll_max_interval": schema.StringAttribute{
+				MarkdownDescription: "Maximum interval between two status checks while waiting for a resource, default: `30s`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRegex, durationRegexDescription),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of an Akuity Platform API call that failed with a transient error (throttling or unavailability), default: `5`. Use `0` to disable retries",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"kube_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "Kubernetes connection settings used to remove the agents of destroyed `akp_cluster` and `akp_kargo_agent` resources, whose write-only `kube_config` is not stored in the state. Agents are left in place if the inventory ConfigMap in that cluster records that another agent was installed there, so use a provider alias per Kubernetes cluster when agents are installed in several clusters",
+				Optional:            true,
+				Attributes:          getProviderKubeconfigAttributes(),
+			},
 		},
 	}
 }
@@ -122,6 +168,30 @@ func (p *AkpProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
+	poll := waiter.DefaultConfig
+	if !config.PollInterval.IsNull() && !config.PollInterval.IsUnknown() {
+		interval, err := time.ParseDuration(config.PollInterval.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid Poll Interval", err.Error())
+		}
+		poll.InitialInterval = interval
+	}
+	if !config.PollMaxInterval.IsNull() && !config.PollMaxInterval.IsUnknown() {
+		interval, err := time.ParseDuration(config.PollMaxInterval.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("poll_max_interval"), "Invalid Poll Max Interval", err.Error())
+		}
+		poll.MaxInterval = interval
+	}
+
+	retry := retryPolicy{
+		maxRetries: defaultMaxRetries,
+		backoff:    defaultRetryBackoff,
+	}
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		retry.maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -137,7 +207,7 @@ func (p *AkpProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	// Get Organizaton ID by name
 	ctx = httpctx.SetAuthorizationHeader(ctx, cred.Scheme(), cred.Credential())
 	gwc := gwoption.NewClient(ServerUrl, skipTLSVerify)
-	orgc := orgcv1.NewOrganizationServiceGatewayClient(gwc)
+	orgc := &retryingOrgClient{OrganizationServiceGatewayClient: orgcv1.NewOrganizationServiceGatewayClient(gwc), policy: retry}
 	res, err := orgc.GetOrganization(ctx, &orgcv1.GetOrganizationRequest{
 		Id:     orgName,
 		IdType: idv1.Type_NAME,
@@ -156,18 +226,22 @@ func (p *AkpProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	orgID := res.Organization.Id
 	tflog.Info(ctx, "Connection successful", map[string]any{"org_id": orgID})
 
-	argoc := argocdv1.NewArgoCDServiceGatewayClient(gwc)
-	kargoc := kargov1.NewKargoServiceGatewayClient(gwc)
-	orgc = orgcv1.NewOrganizationServiceGatewayClient(gwc)
+	argoc := &retryingArgoCDClient{ArgoCDServiceGatewayClient: argocdv1.NewArgoCDServiceGatewayClient(gwc), policy: retry}
+	kargoc := &retryingKargoClient{KargoServiceGatewayClient: kargov1.NewKargoServiceGatewayClient(gwc), policy: retry}
+	apikeyc := &retryingAPIKeyClient{APIKeyServiceGatewayClient: apikeyv1.NewAPIKeyServiceGatewayClient(gwc), policy: retry}
 	akpCli := &AkpCli{
-		Cli:      argoc,
-		KargoCli: kargoc,
-		Cred:     cred,
-		OrgId:    orgID,
-		OrgCli:   orgc,
+		Cli:        argoc,
+		KargoCli:   kargoc,
+		Cred:       cred,
+		OrgId:      orgID,
+		OrgCli:     orgc,
+		APIKeyCli:  apikeyc,
+		Poll:       poll,
+		KubeConfig: config.KubeConfig,
 	}
 	resp.DataSourceData = akpCli
 	resp.ResourceData = akpCli
+	resp.EphemeralResourceData = akpCli
 }
 
 func (p *AkpProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -176,17 +250,38 @@ func (p *AkpProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewAkpClusterResource,
 		NewAkpKargoInstanceResource,
 		NewAkpKargoAgentResource,
+		NewAkpWorkspaceResource,
+		NewAkpOrganizationAPIKeyResource,
+		NewAkpOrganizationMemberResource,
+		NewAkpTeamResource,
+		NewAkpTeamMemberResource,
+		NewAkpOrganizationSSOResource,
 	}
 }
 
 func (p *AkpProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAkpInstanceDataSource,
+		NewAkpInstancesDataSource,
 		NewAkpClusterDataSource,
 		NewAkpClustersDataSource,
+		NewAkpClusterManifestsDataSource,
 		NewAkpKargoDataSource,
+		NewAkpKargoInstancesDataSource,
 		NewAkpKargoAgentDataSource,
 		NewAkpKargoAgentsDataSource,
+		NewAkpKargoAgentManifestsDataSource,
+		NewAkpWorkspacesDataSource,
+		NewAkpOrganizationMemberDataSource,
+		NewAkpTeamDataSource,
+		NewAkpTeamMemberDataSource,
+	}
+}
+
+func (p *AkpProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAkpClusterManifestsEphemeralResource,
+		NewAkpKargoAgentManifestsEphemeralResource,
 	}
 }
 
@@ -0,0 +1,76 @@
+package akp
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// getProviderKubeconfigAttributes returns the attributes of the kube_config of the provider. They are the same as the
+// ones of the kube_config of the resources, but not write-only, the provider configuration is never stored in the state.
+func getProviderKubeconfigAttributes() map[string]schema.Attribute {
+	return toProviderAttributes(getKubeconfigAttributes())
+}
+
+func toProviderAttributes(attrs map[string]resourceschema.Attribute) map[string]schema.Attribute {
+	providerAttrs := make(map[string]schema.Attribute, len(attrs))
+	for name, attr := range attrs {
+		switch a := attr.(type) {
+		case resourceschema.StringAttribute:
+			providerAttrs[name] = schema.StringAttribute{
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+				Validators:  a.Validators,
+			}
+		case resourceschema.BoolAttribute:
+			providerAttrs[name] = schema.BoolAttribute{
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+				Validators:  a.Validators,
+			}
+		case resourceschema.Int64Attribute:
+			providerAttrs[name] = schema.Int64Attribute{
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+				Validators:  a.Validators,
+			}
+		case resourceschema.ListAttribute:
+			providerAttrs[name] = schema.ListAttribute{
+				ElementType: a.ElementType,
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+				Validators:  a.Validators,
+			}
+		case resourceschema.MapAttribute:
+			providerAttrs[name] = schema.MapAttribute{
+				ElementType: a.ElementType,
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+				Validators:  a.Validators,
+			}
+		case resourceschema.SingleNestedAttribute:
+			providerAttrs[name] = schema.SingleNestedAttribute{
+				Attributes:  toProviderAttributes(a.Attributes),
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+				Validators:  a.Validators,
+			}
+		default:
+			panic(fmt.Sprintf("kube_config attribute %q of type %T is not supported by the provider", name, attr))
+		}
+	}
+	return providerAttrs
+}
@@ -0,0 +1,25 @@
+//go:build !acc
+
+package akp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// If this test fails, a field has been added/removed to the Kubeconfig related type.
+// Update the schema attribute accordingly.
+func TestNoNewProviderKubeconfigFields(t *testing.T) {
+	attrs := getProviderKubeconfigAttributes()
+	assert.Equal(t, reflect.TypeOf(types.Kubeconfig{}).NumField(), len(attrs))
+	exec, ok := attrs["exec"].(schema.SingleNestedAttribute)
+	require.True(t, ok)
+	assert.Equal(t, reflect.TypeOf(types.KubeconfigExec{}).NumField(), len(exec.Attributes))
+	assert.True(t, attrs["token"].IsSensitive())
+}
@@ -14,10 +14,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
 
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
@@ -28,12 +30,37 @@ import (
 	"github.com/akuity/terraform-provider-akp/akp/kube"
 	"github.com/akuity/terraform-provider-akp/akp/marshal"
 	"github.com/akuity/terraform-provider-akp/akp/types"
+	"github.com/akuity/terraform-provider-akp/akp/waiter"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &AkpClusterResource{}
 var _ resource.ResourceWithImportState = &AkpClusterResource{}
 
+// Default timeouts for resources that wait on the Akuity Platform when no `timeouts` are configured.
+var (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+)
+
+// defaultParallelism is the number of agent resources applied or deleted concurrently when kube_config does not set it.
+const defaultParallelism = 5
+
+// Names of the ConfigMaps that record the objects applied for an agent, used to prune the objects that upgrades drop.
+const (
+	clusterInventoryName    = "akp-cluster-agent-inventory"
+	kargoAgentInventoryName = "akp-kargo-agent-inventory"
+)
+
+// agentInstalledKey is the private state key recording that Terraform installed the agent with kube_config, which is
+// write-only and therefore not in the state.
+const agentInstalledKey = "agent_installed"
+
+// errAgentNotInstalled is returned when removing an agent from a Kubernetes cluster it was not installed in by Terraform.
+var errAgentNotInstalled = errors.New("agent is not installed in the Kubernetes cluster")
+
 func NewAkpClusterResource() resource.Resource {
 	return &AkpClusterResource{}
 }
@@ -71,14 +98,24 @@ func (r *AkpClusterResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// kube_config is write-only, it is only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("kube_config"), &plan.Kubeconfig)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := r.upsert(ctx, &resp.Diagnostics, &plan, true)
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.CreateTimeout(defaultCreateTimeout))
+	defer cancel()
+	installAgent := plan.Kubeconfig != nil
+	result, err := r.upsert(ctx, &resp.Diagnostics, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 	}
+	if installAgent {
+		// Remember that the agent was installed, so that destroying it fails rather than leaving it behind when the
+		// provider has no kube_config to remove it with.
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, agentInstalledKey, []byte("true"))...)
+	}
 	// In this case we commit state regardless whether there's an error or not. This is because there can be partial
 	// state (e.g. a cluster could be created in AKP but the manifests failed to be applied).
 	if result != nil {
@@ -95,8 +132,11 @@ func (r *AkpClusterResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, data.Timeouts.ReadTimeout(defaultReadTimeout))
+	defer cancel()
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
-	err := refreshClusterState(ctx, &resp.Diagnostics, r.akpCli.Cli, &data, r.akpCli.OrgId, &resp.State, &data)
+	// An imported cluster has no spec yet, it is derived from the API cluster.
+	err := refreshClusterState(ctx, &resp.Diagnostics, r.akpCli.Cli, &data, r.akpCli.OrgId, &resp.State, &data, data.Spec == nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 	} else {
@@ -110,14 +150,24 @@ func (r *AkpClusterResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// kube_config is write-only, it is only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("kube_config"), &plan.Kubeconfig)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := r.upsert(ctx, &resp.Diagnostics, &plan, false)
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.UpdateTimeout(defaultUpdateTimeout))
+	defer cancel()
+	installAgent := plan.Kubeconfig != nil
+	result, err := r.upsert(ctx, &resp.Diagnostics, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 	}
+	if installAgent {
+		// Remember that the agent was installed, so that destroying it fails rather than leaving it behind when the
+		// provider has no kube_config to remove it with.
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, agentInstalledKey, []byte("true"))...)
+	}
 	// In this case we commit state regardless whether there's an error or not. This is because there can be partial
 	// state (e.g. a cluster could be created in AKP but the manifests failed to be applied).
 	if result != nil {
@@ -135,23 +185,38 @@ func (r *AkpClusterResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.DeleteTimeout(defaultDeleteTimeout))
+	defer cancel()
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
-	kubeconfig, err := getKubeconfig(plan.Kubeconfig)
+	// kube_config is write-only and not in the state, the agent is removed with the kube_config of the provider
+	kubeconfig, err := getKubeconfig(r.akpCli.KubeConfig)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
 
 	// Delete the manifests
+	owner := agentOwner(plan.InstanceID.ValueString(), plan.Name.ValueString())
+	if kubeconfig == nil && plan.RemoveAgentResourcesOnDestroy.ValueBool() {
+		agentInstalled, diags := req.Private.GetKey(ctx, agentInstalledKey)
+		resp.Diagnostics.Append(diags...)
+		addAgentNotRemovedDiagnostic(&resp.Diagnostics, agentInstalled != nil, owner)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	if kubeconfig != nil && plan.RemoveAgentResourcesOnDestroy.ValueBool() {
-		manifests, err := getManifests(ctx, r.akpCli.Cli, r.akpCli.OrgId, &plan)
+		manifests, err := getManifests(ctx, r.akpCli.Cli, r.akpCli.Poll, r.akpCli.OrgId, &plan)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", err.Error())
 			return
 		}
 
-		err = deleteManifests(ctx, manifests, kubeconfig)
-		if err != nil {
+		err = deleteManifests(ctx, manifests, kubeconfig, getParallelism(r.akpCli.KubeConfig), clusterInventoryName, owner)
+		if errors.Is(err, errAgentNotInstalled) {
+			// The kube_config of the provider connects to a cluster another agent was installed in.
+			resp.Diagnostics.AddWarning("Agent Resources Not Removed", err.Error())
+		} else if err != nil {
 			resp.Diagnostics.AddError("Client Error", err.Error())
 			return
 		}
@@ -166,38 +231,48 @@ func (r *AkpClusterResource) Delete(ctx context.Context, req resource.DeleteRequ
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Akuity cluster. %s", err))
 		return
 	}
-	// Give it some time to remove the cluster. This is useful when the terraform provider is performing a replace operation, to give it enough time to destroy the previous cluster.
-	time.Sleep(2 * time.Second)
+	// Wait until the cluster is gone. This is useful when the terraform provider is performing a replace operation, to make sure the previous cluster is destroyed.
+	if err := waitClusterDeleted(ctx, r.akpCli.Cli, r.akpCli.Poll, r.akpCli.OrgId, &plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+	}
 }
 
+// ImportState imports a cluster by instance and cluster name, i.e. instance/name.
+// The instance is given the same way the instance itself is imported: by name or ID, optionally prefixed with `name:` or `id:`.
 func (r *AkpClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: instance_id/name. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: instance_name/name or instance_id/name. Got: %q", req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[0])...)
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	instance, err := resolveInstanceImport(ctx, r.akpCli.Cli, r.akpCli.OrgId, idParts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instance.GetId())...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
 }
 
-func (r *AkpClusterResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, plan *types.Cluster, isCreate bool) (*types.Cluster, error) {
+func (r *AkpClusterResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, plan *types.Cluster) (*types.Cluster, error) {
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
 	apiReq := buildClusterApplyRequest(ctx, diagnostics, plan, r.akpCli.OrgId)
 	if diagnostics.HasError() {
 		return nil, nil
 	}
-	result, err := r.applyInstance(ctx, plan, apiReq, isCreate, r.akpCli.Cli.ApplyInstance, r.upsertKubeConfig)
+	result, err := r.applyInstance(ctx, plan, apiReq, r.akpCli.Cli.ApplyInstance, r.upsertKubeConfig)
 	if err != nil {
 		return result, err
 	}
-	return result, refreshClusterState(ctx, diagnostics, r.akpCli.Cli, result, r.akpCli.OrgId, nil, plan)
+	return result, refreshClusterState(ctx, diagnostics, r.akpCli.Cli, result, r.akpCli.OrgId, nil, plan, false)
 }
 
-func (r *AkpClusterResource) applyInstance(ctx context.Context, plan *types.Cluster, apiReq *argocdv1.ApplyInstanceRequest, isCreate bool, applyInstance func(context.Context, *argocdv1.ApplyInstanceRequest) (*argocdv1.ApplyInstanceResponse, error), upsertKubeConfig func(ctx context.Context, plan *types.Cluster, isCreate bool) error) (*types.Cluster, error) {
+func (r *AkpClusterResource) applyInstance(ctx context.Context, plan *types.Cluster, apiReq *argocdv1.ApplyInstanceRequest, applyInstance func(context.Context, *argocdv1.ApplyInstanceRequest) (*argocdv1.ApplyInstanceResponse, error), upsertKubeConfig func(ctx context.Context, plan *types.Cluster) error) (*types.Cluster, error) {
 	kubeconfig := plan.Kubeconfig
 	plan.Kubeconfig = nil
 	tflog.Debug(ctx, fmt.Sprintf("Apply cluster request: %s", apiReq))
@@ -208,10 +283,10 @@ func (r *AkpClusterResource) applyInstance(ctx context.Context, plan *types.Clus
 
 	if kubeconfig != nil {
 		plan.Kubeconfig = kubeconfig
-		err = upsertKubeConfig(ctx, plan, isCreate)
+		err = upsertKubeConfig(ctx, plan)
+		// kube_config is write-only, ensure it won't be committed to state by setting it to nil
+		plan.Kubeconfig = nil
 		if err != nil {
-			// Ensure kubeconfig won't be committed to state by setting it to nil
-			plan.Kubeconfig = nil
 			return plan, fmt.Errorf("unable to apply manifests: %s", err)
 		}
 	}
@@ -219,31 +294,29 @@ func (r *AkpClusterResource) applyInstance(ctx context.Context, plan *types.Clus
 	return plan, nil
 }
 
-func (r *AkpClusterResource) upsertKubeConfig(ctx context.Context, plan *types.Cluster, isCreate bool) error {
-	// Apply agent manifests to clusters if the kubeconfig is specified for cluster.
+// upsertKubeConfig installs or upgrades the agent if the kubeconfig is specified for the cluster, then waits for it to become healthy.
+func (r *AkpClusterResource) upsertKubeConfig(ctx context.Context, plan *types.Cluster) error {
 	kubeconfig, err := getKubeconfig(plan.Kubeconfig)
 	if err != nil {
 		return err
 	}
+	if kubeconfig == nil {
+		return nil
+	}
 
-	// Apply the manifests
-	if kubeconfig != nil && isCreate {
-		manifests, err := getManifests(ctx, r.akpCli.Cli, r.akpCli.OrgId, plan)
-		if err != nil {
-			return err
-		}
-
-		err = applyManifests(ctx, manifests, kubeconfig)
-		if err != nil {
-			return err
-		}
-		return waitClusterHealthStatus(ctx, r.akpCli.Cli, r.akpCli.OrgId, plan)
+	manifests, err := getManifests(ctx, r.akpCli.Cli, r.akpCli.Poll, r.akpCli.OrgId, plan)
+	if err != nil {
+		return err
 	}
-	return nil
+	owner := agentOwner(plan.InstanceID.ValueString(), plan.Name.ValueString())
+	if err = applyManifests(ctx, manifests, kubeconfig, getApplyOpts(plan.Kubeconfig), getParallelism(plan.Kubeconfig), clusterInventoryName, owner); err != nil {
+		return err
+	}
+	return waitClusterHealthStatus(ctx, r.akpCli.Cli, r.akpCli.Poll, r.akpCli.OrgId, plan)
 }
 
 func refreshClusterState(ctx context.Context, diagnostics *diag.Diagnostics, client argocdv1.ArgoCDServiceGatewayClient, cluster *types.Cluster,
-	orgID string, state *tfsdk.State, plan *types.Cluster) error {
+	orgID string, state *tfsdk.State, plan *types.Cluster, imported bool) error {
 	clusterReq := &argocdv1.GetInstanceClusterRequest{
 		OrganizationId: orgID,
 		InstanceId:     cluster.InstanceID.ValueString(),
@@ -261,7 +334,7 @@ func refreshClusterState(ctx context.Context, diagnostics *diag.Diagnostics, cli
 		return errors.Wrap(err, "Unable to read Argo CD cluster")
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Get cluster response: %s", clusterResp))
-	cluster.Update(ctx, diagnostics, clusterResp.GetCluster(), plan)
+	cluster.Update(ctx, diagnostics, clusterResp.GetCluster(), plan, imported)
 	return nil
 }
 
@@ -298,7 +371,24 @@ func getKubeconfig(kubeConfig *types.Kubeconfig) (*rest.Config, error) {
 	return kcfg, nil
 }
 
-func getManifests(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, orgId string, cluster *types.Cluster) (string, error) {
+// getParallelism returns how many agent resources are applied or deleted concurrently with the kubeconfig.
+func getParallelism(kubeConfig *types.Kubeconfig) int {
+	if kubeConfig == nil || kubeConfig.Parallelism.IsNull() || kubeConfig.Parallelism.IsUnknown() {
+		return defaultParallelism
+	}
+	return int(kubeConfig.Parallelism.ValueInt64())
+}
+
+// getApplyOpts returns how the agent manifests are applied with the kubeconfig.
+func getApplyOpts(kubeConfig *types.Kubeconfig) kube.ApplyOpts {
+	return kube.ApplyOpts{
+		ServerSide:     kubeConfig.ServerSideApply.ValueBool(),
+		FieldManager:   kubeConfig.FieldManager.ValueString(),
+		ForceConflicts: kubeConfig.ForceConflicts.ValueBool(),
+	}
+}
+
+func getManifests(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, poll waiter.Config, orgId string, cluster *types.Cluster) (string, error) {
 	clusterReq := &argocdv1.GetInstanceClusterRequest{
 		OrganizationId: orgId,
 		InstanceId:     cluster.InstanceID.ValueString(),
@@ -309,7 +399,7 @@ func getManifests(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClien
 	if err != nil {
 		return "", errors.Wrap(err, "Unable to read instance cluster")
 	}
-	c, err := waitClusterReconStatus(ctx, client, clusterResp.GetCluster(), orgId, cluster.InstanceID.ValueString())
+	c, err := waitClusterReconStatus(ctx, client, poll, clusterResp.GetCluster(), orgId, cluster.InstanceID.ValueString())
 	if err != nil {
 		return "", errors.Wrap(err, "Unable to check cluster reconciliation status")
 	}
@@ -330,7 +420,10 @@ func getManifests(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClien
 	return string(res), nil
 }
 
-func applyManifests(ctx context.Context, manifests string, cfg *rest.Config) error {
+// applyManifests applies the manifests, then prunes the objects of the previous apply that the manifests no longer contain.
+// The applied objects are recorded in the inventory with the given name, in the namespace of the agent, together with the
+// agent that owns them.
+func applyManifests(ctx context.Context, manifests string, cfg *rest.Config, applyOpts kube.ApplyOpts, parallelism int, inventoryName, owner string) error {
 	kubectl, err := kube.NewKubectl(cfg)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create Kubectl")
@@ -339,18 +432,43 @@ func applyManifests(ctx context.Context, manifests string, cfg *rest.Config) err
 	if err != nil {
 		return errors.Wrap(err, "Failed to parse manifests")
 	}
+	inventory := agentInventory(inventoryName, owner, resources)
+	previous, err := kubectl.ReadInventory(ctx, inventory)
+	if err != nil {
+		return err
+	}
 
-	for _, un := range resources {
-		msg, err := kubectl.ApplyResource(ctx, &un, kube.ApplyOpts{})
+	// Tiers are applied one after the other, the resources of a tier concurrently.
+	for _, tier := range kube.Tiers(resources) {
+		err = forEachResource(ctx, tier, parallelism, func(ctx context.Context, un *unstructured.Unstructured) error {
+			msg, err := kubectl.ApplyResource(ctx, un, applyOpts)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("failed to apply manifest: %s/%s", un.GetKind(), un.GetName()))
+			}
+			tflog.Debug(ctx, msg)
+			return nil
+		})
 		if err != nil {
-			return errors.Wrap(err, fmt.Sprintf("failed to apply manifest"))
+			return err
+		}
+		if err = kubectl.WaitForEstablished(ctx, tier); err != nil {
+			return err
 		}
-		tflog.Debug(ctx, msg)
 	}
-	return nil
+
+	// Prune before recording the new inventory, so that objects that failed to be pruned are retried on the next apply.
+	stale := staleResources(previous, resources)
+	tflog.Info(ctx, fmt.Sprintf("%d resources to prune", len(stale)))
+	if err = deleteResources(ctx, kubectl, stale, parallelism); err != nil {
+		return errors.Wrap(err, "failed to prune manifests")
+	}
+	return kubectl.WriteInventory(ctx, inventory, resources)
 }
 
-func deleteManifests(ctx context.Context, manifests string, cfg *rest.Config) error {
+// deleteManifests deletes the manifests, the objects recorded in the inventory with the given name and the inventory itself.
+// Nothing is deleted if the inventory records that another agent was installed in the cluster, errAgentNotInstalled is
+// returned instead.
+func deleteManifests(ctx context.Context, manifests string, cfg *rest.Config, parallelism int, inventoryName, owner string) error {
 	kubectl, err := kube.NewKubectl(cfg)
 	if err != nil {
 		return errors.Wrap(err, "failed to create kubectl")
@@ -360,29 +478,127 @@ func deleteManifests(ctx context.Context, manifests string, cfg *rest.Config) er
 	if err != nil {
 		return errors.Wrap(err, "failed to parse manifests")
 	}
+	inventory := agentInventory(inventoryName, owner, resources)
+	installedBy, err := kubectl.ReadInventoryOwner(ctx, inventory)
+	if err != nil {
+		return err
+	}
+	if err = checkInventoryOwner(inventory, installedBy, owner); err != nil {
+		return err
+	}
+	previous, err := kubectl.ReadInventory(ctx, inventory)
+	if err != nil {
+		return err
+	}
+
+	if err = deleteResources(ctx, kubectl, append(resources, staleResources(previous, resources)...), parallelism); err != nil {
+		return err
+	}
+	return kubectl.DeleteInventory(ctx, inventory)
+}
+
+// checkInventoryOwner returns errAgentNotInstalled if the inventory records that the agent of another owner was installed.
+// Agents installed before inventories were recorded have none, their manifests are deleted as they always were.
+func checkInventoryOwner(inventory kube.Inventory, installedBy, owner string) error {
+	if installedBy != "" && installedBy != owner {
+		return errors.Wrapf(errAgentNotInstalled, "the inventory %s/%s of the Kubernetes cluster records agent %s, not %s", inventory.Namespace, inventory.Name, installedBy, owner)
+	}
+	return nil
+}
 
-	// Delete the resources in reverse order
-	for i := len(resources) - 1; i >= 0; i-- {
-		msg, err := kubectl.DeleteResource(ctx, &resources[i], kube.DeleteOpts{
-			IgnoreNotFound:  true,
-			WaitForDeletion: true,
-			Force:           false,
+// deleteResources deletes the resources tier by tier in reverse order, the resources of a tier concurrently.
+func deleteResources(ctx context.Context, kubectl *kube.Kubectl, resources []unstructured.Unstructured, parallelism int) error {
+	tiers := kube.Tiers(resources)
+	for i := len(tiers) - 1; i >= 0; i-- {
+		err := forEachResource(ctx, tiers[i], parallelism, func(ctx context.Context, un *unstructured.Unstructured) error {
+			msg, err := kubectl.DeleteResource(ctx, un, kube.DeleteOpts{
+				IgnoreNotFound:  true,
+				WaitForDeletion: true,
+				Force:           false,
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("failed to delete manifest: %s", un))
+			}
+			tflog.Debug(ctx, msg)
+			return nil
 		})
 		if err != nil {
-			return errors.Wrap(err, fmt.Sprintf("failed to delete manifest: %s", resources[i]))
+			return err
 		}
-		tflog.Debug(ctx, msg)
 	}
 	return nil
 }
 
-func waitClusterHealthStatus(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, orgID string, c *types.Cluster) error {
-	cluster := &argocdv1.Cluster{}
-	healthStatus := cluster.GetHealthStatus()
+// forEachResource calls fn for each of the resources, at most parallelism at a time, and returns the first error.
+func forEachResource(ctx context.Context, resources []unstructured.Unstructured, parallelism int, fn func(context.Context, *unstructured.Unstructured) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(parallelism)
+	for i := range resources {
+		un := &resources[i]
+		g.Go(func() error {
+			return fn(ctx, un)
+		})
+	}
+	return g.Wait()
+}
+
+// agentInventory returns the inventory with the given name and owner in the namespace the agent is installed in, i.e. the
+// namespace created by the manifests or, for agents that do not create one, the namespace of the first namespaced object.
+func agentInventory(name, owner string, resources []unstructured.Unstructured) kube.Inventory {
+	var namespace string
+	for _, un := range resources {
+		if un.GetKind() == "Namespace" && un.GroupVersionKind().Group == "" {
+			namespace = un.GetName()
+			break
+		}
+		if namespace == "" {
+			namespace = un.GetNamespace()
+		}
+	}
+	return kube.Inventory{Namespace: namespace, Name: name, Owner: owner}
+}
+
+// agentOwner identifies the agent of a cluster or of a Kargo agent in the inventory by its instance and name.
+func agentOwner(instanceID, name string) string {
+	return instanceID + "/" + name
+}
+
+// addAgentNotRemovedDiagnostic reports that the agent resources cannot be removed on destroy because the provider has no
+// kube_config. It is an error if Terraform installed the agent, which would be left behind otherwise, and a warning if not.
+func addAgentNotRemovedDiagnostic(diagnostics *diag.Diagnostics, agentInstalled bool, agent string) {
+	detail := fmt.Sprintf("The Kubernetes resources of agent %s cannot be removed because the provider has no `kube_config`. "+
+		"Configure `kube_config` on the provider, or set `remove_agent_resources_on_destroy = false` to leave them in place.", agent)
+	if agentInstalled {
+		diagnostics.AddError("Missing Provider kube_config", detail)
+		return
+	}
+	diagnostics.AddWarning("Agent Resources Not Removed", detail)
+}
+
+// staleResources returns the previous resources that are not part of the current ones. Resources are matched by group, kind,
+// namespace and name, so that a resource moving to another API version is not pruned.
+func staleResources(previous, current []unstructured.Unstructured) []unstructured.Unstructured {
+	key := func(un unstructured.Unstructured) string {
+		return strings.Join([]string{un.GroupVersionKind().GroupKind().String(), un.GetNamespace(), un.GetName()}, "/")
+	}
+	keep := make(map[string]bool, len(current))
+	for _, un := range current {
+		keep[key(un)] = true
+	}
+	var stale []unstructured.Unstructured
+	for _, un := range previous {
+		if !keep[key(un)] {
+			stale = append(stale, un)
+		}
+	}
+	return stale
+}
+
+func waitClusterHealthStatus(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, poll waiter.Config, orgID string, c *types.Cluster) error {
+	var healthStatus *healthv1.Status
 	breakStatusesHealth := []healthv1.StatusCode{healthv1.StatusCode_STATUS_CODE_HEALTHY, healthv1.StatusCode_STATUS_CODE_DEGRADED}
 
-	for !slices.Contains(breakStatusesHealth, healthStatus.GetCode()) {
-		time.Sleep(1 * time.Second)
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
 		apiResp, err := client.GetInstanceCluster(ctx, &argocdv1.GetInstanceClusterRequest{
 			OrganizationId: orgID,
 			InstanceId:     c.InstanceID.ValueString(),
@@ -390,21 +606,25 @@ func waitClusterHealthStatus(ctx context.Context, client argocdv1.ArgoCDServiceG
 			IdType:         idv1.Type_NAME,
 		})
 		if err != nil {
-			return err
+			return false, errors.Wrapf(err, "unable to check health of cluster %q, last health status: %s", c.Name.ValueString(), healthStatus.GetCode())
 		}
-		cluster = apiResp.GetCluster()
-		healthStatus = cluster.GetHealthStatus()
+		healthStatus = apiResp.GetCluster().GetHealthStatus()
 		tflog.Debug(ctx, fmt.Sprintf("Cluster health status: %s", healthStatus.String()))
+		return slices.Contains(breakStatusesHealth, healthStatus.GetCode()), nil
+	})
+	if waiter.IsTimeout(err) {
+		return fmt.Errorf("timed out waiting for cluster %q to become healthy, last health status: %s: %w", c.Name.ValueString(), healthStatus.GetCode(), err)
 	}
-	return nil
+	return err
 }
 
-func waitClusterReconStatus(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, cluster *argocdv1.Cluster, orgId, instanceId string) (*argocdv1.Cluster, error) {
-	reconStatus := cluster.GetReconciliationStatus()
+func waitClusterReconStatus(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, poll waiter.Config, cluster *argocdv1.Cluster, orgId, instanceId string) (*argocdv1.Cluster, error) {
 	breakStatusesRecon := []reconv1.StatusCode{reconv1.StatusCode_STATUS_CODE_SUCCESSFUL, reconv1.StatusCode_STATUS_CODE_FAILED}
+	if slices.Contains(breakStatusesRecon, cluster.GetReconciliationStatus().GetCode()) {
+		return cluster, nil
+	}
 
-	for !slices.Contains(breakStatusesRecon, reconStatus.GetCode()) {
-		time.Sleep(1 * time.Second)
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
 		apiResp, err := client.GetInstanceCluster(ctx, &argocdv1.GetInstanceClusterRequest{
 			OrganizationId: orgId,
 			InstanceId:     instanceId,
@@ -412,15 +632,44 @@ func waitClusterReconStatus(ctx context.Context, client argocdv1.ArgoCDServiceGa
 			IdType:         idv1.Type_ID,
 		})
 		if err != nil {
-			return nil, err
+			return false, errors.Wrapf(err, "unable to check reconciliation of cluster %q, last reconciliation status: %s", cluster.GetName(), cluster.GetReconciliationStatus().GetCode())
 		}
 		cluster = apiResp.GetCluster()
-		reconStatus = cluster.GetReconciliationStatus()
-		tflog.Debug(ctx, fmt.Sprintf("Cluster recon status: %s", reconStatus.String()))
+		tflog.Debug(ctx, fmt.Sprintf("Cluster recon status: %s", cluster.GetReconciliationStatus().String()))
+		return slices.Contains(breakStatusesRecon, cluster.GetReconciliationStatus().GetCode()), nil
+	})
+	if waiter.IsTimeout(err) {
+		return nil, fmt.Errorf("timed out waiting for cluster %q to reconcile, last reconciliation status: %s: %w", cluster.GetName(), cluster.GetReconciliationStatus().GetCode(), err)
+	}
+	if err != nil {
+		return nil, err
 	}
 	return cluster, nil
 }
 
+// waitClusterDeleted polls the cluster until the API no longer knows about it.
+func waitClusterDeleted(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, poll waiter.Config, orgID string, c *types.Cluster) error {
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
+		_, err := client.GetInstanceCluster(ctx, &argocdv1.GetInstanceClusterRequest{
+			OrganizationId: orgID,
+			InstanceId:     c.InstanceID.ValueString(),
+			Id:             c.ID.ValueString(),
+			IdType:         idv1.Type_ID,
+		})
+		if status.Code(err) == codes.NotFound {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to check deletion of cluster %q", c.Name.ValueString())
+		}
+		return false, nil
+	})
+	if waiter.IsTimeout(err) {
+		return fmt.Errorf("timed out waiting for cluster %q to be deleted: %w", c.Name.ValueString(), err)
+	}
+	return err
+}
+
 func readStream(resChan <-chan *httpbody.HttpBody, errChan <-chan error) ([]byte, error) {
 	var data []byte
 	for resChan != nil && errChan != nil {
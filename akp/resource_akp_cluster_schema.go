@@ -4,13 +4,14 @@ import (
 	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -29,6 +30,8 @@ var (
 
 	resourceNameRegex            = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
 	resourceNameRegexDescription = "resource name must consist of lower case alphanumeric characters, digits or '-', and must start with an alphanumeric character, and end with an alphanumeric character or a digit"
+	durationRegex                = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+	durationRegexDescription     = "must be a valid duration, e.g. `30s`, `10m` or `1h30m`"
 )
 
 func (r *AkpClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -101,16 +104,22 @@ func getAKPClusterAttributes() map[string]schema.Attribute {
 			Attributes:          getClusterSpecAttributes(),
 		},
 		"kube_config": schema.SingleNestedAttribute{
-			MarkdownDescription: "Kubernetes connection settings. If configured, terraform will try to connect to the cluster and install the agent",
+			MarkdownDescription: "Kubernetes connection settings. If configured, terraform will try to connect to the cluster and install the agent, and upgrade it whenever the resource is updated. Agent resources that are no longer part of the upgraded manifests are removed, the applied resources are tracked in a ConfigMap in the agent namespace. Write-only, requires Terraform 1.11 or later: the credentials are never stored in the plan or the state, destroying the resource removes the agent with the `kube_config` of the provider",
 			Optional:            true,
+			WriteOnly:           true,
 			Attributes:          getKubeconfigAttributes(),
 		},
 		"remove_agent_resources_on_destroy": schema.BoolAttribute{
-			MarkdownDescription: "Remove agent Kubernetes resources from the managed cluster when destroying cluster, default to `true`",
+			MarkdownDescription: "Remove agent Kubernetes resources from the managed cluster when destroying cluster, default to `true`. The resources are removed with the `kube_config` of the provider, unless the inventory ConfigMap in that cluster records that another agent was installed there, in which case they are left in place with a warning. Destroying fails if Terraform installed the agent but the provider has no `kube_config`",
 			Optional:            true,
 			Computed:            true,
 			Default:             booldefault.StaticBool(true),
 		},
+		"timeouts": schema.SingleNestedAttribute{
+			MarkdownDescription: "Timeouts for the cluster operations, including waiting for the agent to become healthy",
+			Optional:            true,
+			Attributes:          getTimeoutsAttributes(),
+		},
 	}
 }
 
@@ -242,108 +251,189 @@ func getClusterDataAttributes() map[string]schema.Attribute {
 	}
 }
 
+func getKubeconfigExecAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"api_version": schema.StringAttribute{
+			Required:    true,
+			WriteOnly:   true,
+			Description: "API version of the exec credential the plugin returns, e.g. `client.authentication.k8s.io/v1beta1`",
+		},
+		"command": schema.StringAttribute{
+			Required:    true,
+			WriteOnly:   true,
+			Description: "Command to execute",
+		},
+		"args": schema.ListAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			ElementType: types.StringType,
+			Description: "Arguments to pass to the command",
+		},
+		"env": schema.MapAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			ElementType: types.StringType,
+			Description: "Environment variables to set when executing the command",
+		},
+	}
+}
+
 func getKubeconfigAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"host": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "The hostname (in form of URI) of Kubernetes master.",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"username": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "The username to use for HTTP basic authentication when accessing the Kubernetes master endpoint.",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"password": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Sensitive:   true,
 			Description: "The password to use for HTTP basic authentication when accessing the Kubernetes master endpoint.",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"insecure": schema.BoolAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "Whether server should be accessed without verifying the TLS certificate.",
-			PlanModifiers: []planmodifier.Bool{
-				boolplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"client_certificate": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "PEM-encoded client certificate for TLS authentication.",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"client_key": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Sensitive:   true,
 			Description: "PEM-encoded client certificate key for TLS authentication.",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"cluster_ca_certificate": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "PEM-encoded root certificates bundle for TLS authentication.",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"config_paths": schema.ListAttribute{
 			ElementType: types.StringType,
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "A list of paths to kube config files.",
-			PlanModifiers: []planmodifier.List{
-				listplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"config_path": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "Path to the kube config file.",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
+		},
+		"config_raw": schema.StringAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Sensitive:   true,
+			Description: "Content of a kube config file, e.g. the output of a module that creates the cluster. `config_context`, `config_context_auth_info` and `config_context_cluster` select from it the same way as from the files of `config_path`",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("config_path"),
+					path.MatchRelative().AtParent().AtName("config_paths"),
+				),
 			},
 		},
 		"config_context": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "Context name to load from the kube config file.",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"config_context_auth_info": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"config_context_cluster": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"token": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Sensitive:   true,
 			Description: "Token to authenticate an service account",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
 		},
 		"proxy_url": schema.StringAttribute{
 			Optional:    true,
+			WriteOnly:   true,
 			Description: "URL to the proxy to be used for all API requests",
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
+		},
+		"tls_server_name": schema.StringAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Description: "Server name to verify the TLS certificate of the Kubernetes master against, instead of the hostname of `host`",
+		},
+		"exec": schema.SingleNestedAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Description: "Exec plugin that provides the credentials, e.g. `aws eks get-token`",
+			Attributes:  getKubeconfigExecAttributes(),
+		},
+		"server_side_apply": schema.BoolAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Description: "Apply the agent manifests server-side instead of client-side. Clusters that do not support server-side apply fall back to client-side apply",
+		},
+		"field_manager": schema.StringAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Description: "Field manager that owns the fields of server-side applied agent manifests, default to `terraform-provider-akp`",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"force_conflicts": schema.BoolAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Description: "Take ownership of the fields of server-side applied agent manifests that conflict with other field managers",
+		},
+		"parallelism": schema.Int64Attribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Description: "Number of agent resources applied or deleted concurrently, default to `5`. Namespaces and CRDs are applied first, then service accounts and RBAC, then the remaining resources",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func getTimeoutsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"create": schema.StringAttribute{
+			Optional:    true,
+			Description: "Timeout for creating the resource, default to `20m`.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationRegex, durationRegexDescription),
+			},
+		},
+		"update": schema.StringAttribute{
+			Optional:    true,
+			Description: "Timeout for updating the resource, default to `20m`.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationRegex, durationRegexDescription),
+			},
+		},
+		"delete": schema.StringAttribute{
+			Optional:    true,
+			Description: "Timeout for deleting the resource, default to `10m`.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationRegex, durationRegexDescription),
+			},
+		},
+		"read": schema.StringAttribute{
+			Optional:    true,
+			Description: "Timeout for reading the resource, default to `5m`.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationRegex, durationRegexDescription),
 			},
 		},
 	}
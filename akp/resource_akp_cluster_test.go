@@ -4,15 +4,27 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	hashitype "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"sigs.k8s.io/yaml"
 
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+	healthv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
+	"github.com/akuity/terraform-provider-akp/akp/kube"
 	"github.com/akuity/terraform-provider-akp/akp/types"
+	"github.com/akuity/terraform-provider-akp/akp/waiter"
 )
 
 func TestAccClusterResource(t *testing.T) {
@@ -128,9 +140,8 @@ func TestAkpClusterResource_applyInstance(t *testing.T) {
 	type args struct {
 		plan             *types.Cluster
 		apiReq           *argocdv1.ApplyInstanceRequest
-		isCreate         bool
 		applyInstance    func(context.Context, *argocdv1.ApplyInstanceRequest) (*argocdv1.ApplyInstanceResponse, error)
-		upsertKubeConfig func(ctx context.Context, plan *types.Cluster, isCreate bool) error
+		upsertKubeConfig func(ctx context.Context, plan *types.Cluster) error
 	}
 	tests := []struct {
 		name  string
@@ -145,7 +156,7 @@ func TestAkpClusterResource_applyInstance(t *testing.T) {
 				applyInstance: func(ctx context.Context, request *argocdv1.ApplyInstanceRequest) (*argocdv1.ApplyInstanceResponse, error) {
 					return &argocdv1.ApplyInstanceResponse{}, nil
 				},
-				upsertKubeConfig: func(ctx context.Context, plan *types.Cluster, isCreate bool) error {
+				upsertKubeConfig: func(ctx context.Context, plan *types.Cluster) error {
 					return errors.New("this should not be called")
 				},
 			},
@@ -159,7 +170,7 @@ func TestAkpClusterResource_applyInstance(t *testing.T) {
 				applyInstance: func(ctx context.Context, request *argocdv1.ApplyInstanceRequest) (*argocdv1.ApplyInstanceResponse, error) {
 					return &argocdv1.ApplyInstanceResponse{}, errors.New("some error")
 				},
-				upsertKubeConfig: func(ctx context.Context, plan *types.Cluster, isCreate bool) error {
+				upsertKubeConfig: func(ctx context.Context, plan *types.Cluster) error {
 					return errors.New("this should not be called")
 				},
 			},
@@ -177,7 +188,7 @@ func TestAkpClusterResource_applyInstance(t *testing.T) {
 				applyInstance: func(ctx context.Context, request *argocdv1.ApplyInstanceRequest) (*argocdv1.ApplyInstanceResponse, error) {
 					return &argocdv1.ApplyInstanceResponse{}, nil
 				},
-				upsertKubeConfig: func(ctx context.Context, plan *types.Cluster, isCreate bool) error {
+				upsertKubeConfig: func(ctx context.Context, plan *types.Cluster) error {
 					assert.Equal(t, &types.Cluster{
 						Kubeconfig: &types.Kubeconfig{
 							Host: hashitype.StringValue("some-host"),
@@ -187,9 +198,7 @@ func TestAkpClusterResource_applyInstance(t *testing.T) {
 				},
 			},
 			want: &types.Cluster{
-				Kubeconfig: &types.Kubeconfig{
-					Host: hashitype.StringValue("some-host"),
-				},
+				Kubeconfig: nil,
 			},
 			error: nil,
 		},
@@ -204,7 +213,7 @@ func TestAkpClusterResource_applyInstance(t *testing.T) {
 				applyInstance: func(ctx context.Context, request *argocdv1.ApplyInstanceRequest) (*argocdv1.ApplyInstanceResponse, error) {
 					return &argocdv1.ApplyInstanceResponse{}, nil
 				},
-				upsertKubeConfig: func(ctx context.Context, plan *types.Cluster, isCreate bool) error {
+				upsertKubeConfig: func(ctx context.Context, plan *types.Cluster) error {
 					return errors.New("some kube apply error")
 				},
 			},
@@ -216,9 +225,319 @@ func TestAkpClusterResource_applyInstance(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &AkpClusterResource{}
 			ctx := context.Background()
-			got, err := r.applyInstance(ctx, tt.args.plan, tt.args.apiReq, tt.args.isCreate, tt.args.applyInstance, tt.args.upsertKubeConfig)
+			got, err := r.applyInstance(ctx, tt.args.plan, tt.args.apiReq, tt.args.applyInstance, tt.args.upsertKubeConfig)
 			assert.Equal(t, tt.error, err)
-			assert.Equalf(t, tt.want, got, "applyInstance(%v, %v, %v)", tt.args.plan, tt.args.apiReq, tt.args.isCreate)
+			assert.Equalf(t, tt.want, got, "applyInstance(%v, %v)", tt.args.plan, tt.args.apiReq)
 		})
 	}
 }
+
+type fakeArgoCDClient struct {
+	argocdv1.ArgoCDServiceGatewayClient
+	getInstanceCluster    func(context.Context, *argocdv1.GetInstanceClusterRequest) (*argocdv1.GetInstanceClusterResponse, error)
+	deleteInstanceCluster func(context.Context, *argocdv1.DeleteInstanceClusterRequest) (*argocdv1.DeleteInstanceClusterResponse, error)
+	getInstance           func(context.Context, *argocdv1.GetInstanceRequest) (*argocdv1.GetInstanceResponse, error)
+}
+
+func (c *fakeArgoCDClient) GetInstance(ctx context.Context, req *argocdv1.GetInstanceRequest) (*argocdv1.GetInstanceResponse, error) {
+	return c.getInstance(ctx, req)
+}
+
+func (c *fakeArgoCDClient) GetInstanceCluster(ctx context.Context, req *argocdv1.GetInstanceClusterRequest) (*argocdv1.GetInstanceClusterResponse, error) {
+	return c.getInstanceCluster(ctx, req)
+}
+
+func (c *fakeArgoCDClient) DeleteInstanceCluster(ctx context.Context, req *argocdv1.DeleteInstanceClusterRequest) (*argocdv1.DeleteInstanceClusterResponse, error) {
+	return c.deleteInstanceCluster(ctx, req)
+}
+
+var testPollConfig = waiter.Config{
+	InitialInterval: 1 * time.Millisecond,
+	MaxInterval:     5 * time.Millisecond,
+}
+
+func TestWaitClusterHealthStatus_timeout(t *testing.T) {
+	client := &fakeArgoCDClient{
+		getInstanceCluster: func(ctx context.Context, req *argocdv1.GetInstanceClusterRequest) (*argocdv1.GetInstanceClusterResponse, error) {
+			return &argocdv1.GetInstanceClusterResponse{
+				Cluster: &argocdv1.Cluster{
+					HealthStatus: &healthv1.Status{Code: healthv1.StatusCode_STATUS_CODE_PROGRESSING},
+				},
+			}, nil
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := waitClusterHealthStatus(ctx, client, testPollConfig, "org", &types.Cluster{Name: hashitype.StringValue("test")})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, `timed out waiting for cluster "test" to become healthy, last health status: STATUS_CODE_PROGRESSING`)
+}
+
+func TestWaitClusterDeleted(t *testing.T) {
+	calls := 0
+	client := &fakeArgoCDClient{
+		getInstanceCluster: func(ctx context.Context, req *argocdv1.GetInstanceClusterRequest) (*argocdv1.GetInstanceClusterResponse, error) {
+			calls++
+			assert.Equal(t, "cluster-id", req.Id)
+			assert.Equal(t, idv1.Type_ID, req.IdType)
+			if calls < 3 {
+				return &argocdv1.GetInstanceClusterResponse{Cluster: &argocdv1.Cluster{Id: "cluster-id"}}, nil
+			}
+			return nil, status.Error(codes.NotFound, "cluster not found")
+		},
+	}
+	err := waitClusterDeleted(context.Background(), client, testPollConfig, "org", &types.Cluster{
+		ID:   hashitype.StringValue("cluster-id"),
+		Name: hashitype.StringValue("test"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRefreshClusterState_import(t *testing.T) {
+	var kustomization map[string]any
+	assert.NoError(t, yaml.Unmarshal([]byte(`apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+images:
+- name: quay.io/akuity/agent
+  newName: registry.example.com/akuity/agent
+patches:
+- patch: |-
+    apiVersion: apps/v1
+    kind: Deployment
+    metadata:
+      name: argocd-repo-server
+    spec:
+      template:
+        spec:
+          containers:
+            - name: argocd-repo-server
+              resources:
+                limits:
+                  memory: 2Gi
+                requests:
+                  cpu: 1
+                  memory: 2Gi
+  target:
+    kind: Deployment
+    name: argocd-repo-server
+replicas:
+- count: 3
+  name: argocd-repo-server
+`), &kustomization))
+	kustomizationStruct, err := structpb.NewStruct(kustomization)
+	assert.NoError(t, err)
+	client := &fakeArgoCDClient{
+		getInstanceCluster: func(ctx context.Context, req *argocdv1.GetInstanceClusterRequest) (*argocdv1.GetInstanceClusterResponse, error) {
+			assert.Equal(t, "test", req.Id)
+			assert.Equal(t, idv1.Type_NAME, req.IdType)
+			return &argocdv1.GetInstanceClusterResponse{
+				Cluster: &argocdv1.Cluster{
+					Id:        "cluster-id",
+					Name:      "test",
+					Namespace: "akuity",
+					Data: &argocdv1.ClusterData{
+						Size:          argocdv1.ClusterSize_CLUSTER_SIZE_LARGE,
+						Kustomization: kustomizationStruct,
+						Labels:        map[string]string{"env": "prod"},
+						Annotations:   map[string]string{"team": "platform"},
+					},
+				},
+			}, nil
+		},
+	}
+
+	// An imported cluster has no spec yet, refreshing it is what populates the generated configuration.
+	cluster := &types.Cluster{
+		InstanceID: hashitype.StringValue("instance-id"),
+		Name:       hashitype.StringValue("test"),
+	}
+	diags := diag.Diagnostics{}
+	err = refreshClusterState(context.Background(), &diags, client, cluster, "org", &tfsdk.State{}, cluster, true)
+	assert.NoError(t, err)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "cluster-id", cluster.ID.ValueString())
+	assert.Equal(t, map[string]attr.Value{"env": hashitype.StringValue("prod")}, cluster.Labels.Elements())
+	assert.Equal(t, map[string]attr.Value{"team": hashitype.StringValue("platform")}, cluster.Annotations.Elements())
+	assert.Equal(t, "custom", cluster.Spec.Data.Size.ValueString())
+	assert.Equal(t, &types.CustomAgentSizeConfig{
+		RepoServer: &types.RepoServerCustomAgentSizeConfig{
+			Memory:   hashitype.StringValue("2Gi"),
+			Cpu:      hashitype.StringValue("1"),
+			Replicas: hashitype.Int64Value(3),
+		},
+	}, cluster.Spec.Data.CustomAgentSizeConfig)
+	assert.NotContains(t, cluster.Spec.Data.Kustomization.ValueString(), "argocd-repo-server")
+	assert.Contains(t, cluster.Spec.Data.Kustomization.ValueString(), "registry.example.com/akuity/agent")
+
+	// Applying the imported configuration must send the size patches back unchanged.
+	apiCluster := cluster.ToClusterAPIModel(context.Background(), &diags)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "large", string(apiCluster.Spec.Data.Size))
+	var applied map[string]any
+	assert.NoError(t, yaml.Unmarshal(apiCluster.Spec.Data.Kustomization.Raw, &applied))
+	assert.Equal(t, kustomization["replicas"], applied["replicas"])
+	assert.Len(t, applied["patches"], 1)
+
+	// Refreshing again with the imported state as the plan must not change it.
+	refreshed := *cluster
+	refreshed.Spec = &types.ClusterSpec{Data: cluster.Spec.Data}
+	err = refreshClusterState(context.Background(), &diags, client, &refreshed, "org", &tfsdk.State{}, cluster, false)
+	assert.NoError(t, err)
+	assert.Equal(t, cluster.Spec, refreshed.Spec)
+
+	// Data sources are not imported, they report the size and kustomization of the API cluster as is.
+	read := &types.Cluster{
+		InstanceID: hashitype.StringValue("instance-id"),
+		Name:       hashitype.StringValue("test"),
+	}
+	err = refreshClusterState(context.Background(), &diags, client, read, "org", &tfsdk.State{}, read, false)
+	assert.NoError(t, err)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "large", read.Spec.Data.Size.ValueString())
+	assert.Nil(t, read.Spec.Data.CustomAgentSizeConfig)
+	assert.Contains(t, read.Spec.Data.Kustomization.ValueString(), "argocd-repo-server")
+}
+
+func TestStaleResources(t *testing.T) {
+	previous, err := kube.SplitYAML([]byte(`apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: argocd-application-controller
+  namespace: akuity
+---
+apiVersion: policy/v1beta1
+kind: PodDisruptionBudget
+metadata:
+  name: argocd-repo-server
+  namespace: akuity
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: argocd-notifications-cm
+  namespace: akuity
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: argocd-repo-server
+  namespace: akuity
+`))
+	assert.NoError(t, err)
+	current, err := kube.SplitYAML([]byte(`apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: argocd-application-controller
+  namespace: akuity
+---
+apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: argocd-repo-server
+  namespace: akuity
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: argocd-repo-server
+  namespace: akuity-agent
+`))
+	assert.NoError(t, err)
+
+	var names []string
+	for _, un := range staleResources(previous, current) {
+		names = append(names, un.GetKind()+"/"+un.GetNamespace()+"/"+un.GetName())
+	}
+	// The PodDisruptionBudget only changed its API version and is kept.
+	assert.Equal(t, []string{"ConfigMap/akuity/argocd-notifications-cm", "Deployment/akuity/argocd-repo-server"}, names)
+	assert.Empty(t, staleResources(current, current))
+}
+
+func TestAgentInventory(t *testing.T) {
+	resources, err := kube.SplitYAML([]byte(`apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: akuity-agent
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: akuity
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: akuity-agent
+  namespace: akuity
+`))
+	assert.NoError(t, err)
+	owner := agentOwner("instance-id", "cluster")
+	assert.Equal(t, "instance-id/cluster", owner)
+	assert.Equal(t, kube.Inventory{Namespace: "akuity", Name: clusterInventoryName, Owner: owner}, agentInventory(clusterInventoryName, owner, resources))
+
+	// Namespace scoped agents do not create their namespace.
+	assert.Equal(t, kube.Inventory{Namespace: "akuity", Name: clusterInventoryName, Owner: owner}, agentInventory(clusterInventoryName, owner, resources[2:]))
+}
+
+func TestCheckInventoryOwner(t *testing.T) {
+	inventory := kube.Inventory{Namespace: "akuity", Name: clusterInventoryName}
+	assert.NoError(t, checkInventoryOwner(inventory, "instance-id/test", "instance-id/test"))
+	// Agents installed before inventories were recorded have none, they are removed as they always were.
+	assert.NoError(t, checkInventoryOwner(inventory, "", "instance-id/test"))
+	err := checkInventoryOwner(inventory, "instance-id/other", "instance-id/test")
+	assert.ErrorIs(t, err, errAgentNotInstalled)
+	assert.ErrorContains(t, err, "akuity/"+clusterInventoryName)
+}
+
+func TestAddAgentNotRemovedDiagnostic(t *testing.T) {
+	// Agents that Terraform installed must not be left behind silently.
+	var diags diag.Diagnostics
+	addAgentNotRemovedDiagnostic(&diags, true, "instance-id/cluster")
+	assert.Equal(t, 1, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), "instance-id/cluster")
+
+	diags = diag.Diagnostics{}
+	addAgentNotRemovedDiagnostic(&diags, false, "instance-id/cluster")
+	assert.Equal(t, 0, diags.ErrorsCount())
+	assert.Equal(t, 1, diags.WarningsCount())
+}
+
+func TestRefreshAgentManifests(t *testing.T) {
+	manifests := `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: akuity
+---
+# comment only
+---
+apiVersion: v1
+data:
+  token: c2VjcmV0
+kind: Secret
+metadata:
+  name: akuity-agent
+  namespace: akuity
+`
+	data := types.AgentManifests{}
+	assert.NoError(t, refreshAgentManifests(&data, manifests))
+	assert.Equal(t, hashitype.StringValue(manifests), data.Manifests)
+	assert.Equal(t, []hashitype.String{
+		hashitype.StringValue(`apiVersion: v1
+kind: Namespace
+metadata:
+  name: akuity
+`),
+		hashitype.StringValue(`apiVersion: v1
+data:
+  token: c2VjcmV0
+kind: Secret
+metadata:
+  name: akuity-agent
+  namespace: akuity
+`),
+	}, data.Documents)
+
+	assert.Error(t, refreshAgentManifests(&data, "kind: ["))
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/pkg/errors"
 
@@ -13,14 +12,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/akuity/terraform-provider-akp/akp/marshal"
 
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
 	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+	healthv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
 	"github.com/akuity/terraform-provider-akp/akp/types"
+	"github.com/akuity/terraform-provider-akp/akp/waiter"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -67,11 +73,15 @@ func (r *AkpInstanceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	err := r.upsert(ctx, &resp.Diagnostics, &plan)
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.CreateTimeout(defaultCreateTimeout))
+	defer cancel()
+	result, err := r.upsert(ctx, &resp.Diagnostics, &plan)
+	// Commit the state even if waiting for the instance to become healthy failed, the instance exists by then.
+	if result != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
-	} else {
-		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
 }
 
@@ -84,10 +94,12 @@ func (r *AkpInstanceResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, data.Timeouts.ReadTimeout(defaultReadTimeout))
+	defer cancel()
 	tflog.MaskLogStrings(ctx, data.GetSensitiveStrings(ctx, &resp.Diagnostics)...)
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
 
-	err := refreshState(ctx, &resp.Diagnostics, r.akpCli.Cli, &data, r.akpCli.OrgId)
+	err := refreshState(ctx, &resp.Diagnostics, r.akpCli.Cli, r.akpCli.OrgCli, &data, r.akpCli.OrgId)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 	} else {
@@ -97,19 +109,28 @@ func (r *AkpInstanceResource) Read(ctx context.Context, req resource.ReadRequest
 
 func (r *AkpInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, "Updating an instance")
-	var plan types.Instance
+	var plan, state types.Instance
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.upsert(ctx, &resp.Diagnostics, &plan)
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.UpdateTimeout(defaultUpdateTimeout))
+	defer cancel()
+	if err := r.moveWorkspace(ctx, &plan, &state); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	result, err := r.upsert(ctx, &resp.Diagnostics, &plan)
+	// Commit the state even if waiting for the instance to become healthy failed, the instance exists by then.
+	if result != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
-	} else {
-		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
 }
 
@@ -122,6 +143,8 @@ func (r *AkpInstanceResource) Delete(ctx context.Context, req resource.DeleteReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, state.Timeouts.DeleteTimeout(defaultDeleteTimeout))
+	defer cancel()
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
 	_, err := r.akpCli.Cli.DeleteInstance(ctx, &argocdv1.DeleteInstanceRequest{
 		Id:             state.ID.ValueString(),
@@ -132,30 +155,139 @@ func (r *AkpInstanceResource) Delete(ctx context.Context, req resource.DeleteReq
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Argo CD instance, got error: %s", err))
 		return
 	}
-	// Give it some time to remove the instance. This is useful when the terraform provider is performing a replace operation, to give it enough time to destroy the previous instance.
-	time.Sleep(2 * time.Second)
+	// Wait until the instance is gone. This is useful when the terraform provider is performing a replace operation, to make sure the previous instance is destroyed.
+	if err := waitInstanceDeleted(ctx, r.akpCli.Cli, r.akpCli.Poll, r.akpCli.OrgId, &state); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+	}
 }
 
+// ImportState imports an Argo CD instance by `id:<id>`, `name:<name>`, or by name or ID, trying the name first.
 func (r *AkpInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	instance, err := resolveInstanceImport(ctx, r.akpCli.Cli, r.akpCli.OrgId, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), instance.GetId())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), instance.GetName())...)
 }
 
-func (r *AkpInstanceResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, plan *types.Instance) error {
+func (r *AkpInstanceResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, plan *types.Instance) (*types.Instance, error) {
 	// Mark sensitive secret data
 	tflog.MaskLogStrings(ctx, plan.GetSensitiveStrings(ctx, diagnostics)...)
 
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
-	apiReq := buildApplyRequest(ctx, diagnostics, plan, r.akpCli.OrgId)
+	workspace, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, plan.Workspace.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := buildApplyRequest(ctx, diagnostics, plan, r.akpCli.OrgId, workspace.GetId())
 	tflog.Debug(ctx, fmt.Sprintf("Apply instance request: %s", apiReq.Argocd))
-	_, err := r.akpCli.Cli.ApplyInstance(ctx, apiReq)
+	_, err = r.akpCli.Cli.ApplyInstance(ctx, apiReq)
 	if err != nil {
-		return errors.Wrap(err, "Unable to upsert Argo CD instance")
+		return nil, errors.Wrap(err, "Unable to upsert Argo CD instance")
 	}
 
-	return refreshState(ctx, diagnostics, r.akpCli.Cli, plan, r.akpCli.OrgId)
+	if err := refreshState(ctx, diagnostics, r.akpCli.Cli, r.akpCli.OrgCli, plan, r.akpCli.OrgId); err != nil {
+		return nil, err
+	}
+	if plan.WaitForHealthy.ValueBool() {
+		return plan, waitInstanceHealthStatus(ctx, r.akpCli.Cli, r.akpCli.Poll, r.akpCli.OrgId, plan)
+	}
+	return plan, nil
 }
 
-func buildApplyRequest(ctx context.Context, diagnostics *diag.Diagnostics, instance *types.Instance, orgID string) *argocdv1.ApplyInstanceRequest {
+// moveWorkspace moves an existing instance to the planned workspace when it differs from the one in state.
+func (r *AkpInstanceResource) moveWorkspace(ctx context.Context, plan, state *types.Instance) error {
+	if plan.Workspace.IsUnknown() || plan.Workspace.Equal(state.Workspace) {
+		return nil
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	from, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, state.Workspace.ValueString())
+	if err != nil {
+		return err
+	}
+	to, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, plan.Workspace.ValueString())
+	if err != nil {
+		return err
+	}
+	if from.GetId() == to.GetId() {
+		return nil
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Moving Argo CD instance %s from workspace %s to %s", state.ID.ValueString(), from.GetId(), to.GetId()))
+	_, err = r.akpCli.Cli.UpdateInstanceWorkspace(ctx, &argocdv1.UpdateInstanceWorkspaceRequest{
+		OrganizationId: r.akpCli.OrgId,
+		Id:             state.ID.ValueString(),
+		WorkspaceId:    from.GetId(),
+		NewWorkspaceId: to.GetId(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Unable to move Argo CD instance to workspace")
+	}
+	return nil
+}
+
+// waitInstanceHealthStatus polls the Argo CD instance until it is reconciled and healthy.
+// A failed reconciliation or a degraded instance is reported as an error.
+func waitInstanceHealthStatus(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, poll waiter.Config, orgID string, instance *types.Instance) error {
+	var argoInstance *argocdv1.Instance
+	breakStatusesHealth := []healthv1.StatusCode{healthv1.StatusCode_STATUS_CODE_HEALTHY, healthv1.StatusCode_STATUS_CODE_DEGRADED}
+	breakStatusesRecon := []reconv1.StatusCode{reconv1.StatusCode_STATUS_CODE_SUCCESSFUL, reconv1.StatusCode_STATUS_CODE_FAILED}
+
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
+		apiResp, err := client.GetInstance(ctx, &argocdv1.GetInstanceRequest{
+			OrganizationId: orgID,
+			Id:             instance.ID.ValueString(),
+			IdType:         idv1.Type_ID,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to check health of Argo CD instance %q, last health status: %s", instance.Name.ValueString(), argoInstance.GetHealthStatus().GetCode())
+		}
+		argoInstance = apiResp.GetInstance()
+		tflog.Debug(ctx, fmt.Sprintf("Instance health status: %s, recon status: %s", argoInstance.GetHealthStatus().String(), argoInstance.GetReconciliationStatus().String()))
+		return slices.Contains(breakStatusesRecon, argoInstance.GetReconciliationStatus().GetCode()) &&
+			slices.Contains(breakStatusesHealth, argoInstance.GetHealthStatus().GetCode()), nil
+	})
+	if waiter.IsTimeout(err) {
+		return fmt.Errorf("timed out waiting for Argo CD instance %q to become healthy, last health status: %s, last reconciliation status: %s: %w",
+			instance.Name.ValueString(), argoInstance.GetHealthStatus().GetCode(), argoInstance.GetReconciliationStatus().GetCode(), err)
+	}
+	if err != nil {
+		return err
+	}
+	if argoInstance.GetReconciliationStatus().GetCode() == reconv1.StatusCode_STATUS_CODE_FAILED {
+		return fmt.Errorf("Argo CD instance %q failed to reconcile: %s", instance.Name.ValueString(), argoInstance.GetReconciliationStatus().GetMessage())
+	}
+	if argoInstance.GetHealthStatus().GetCode() == healthv1.StatusCode_STATUS_CODE_DEGRADED {
+		return fmt.Errorf("Argo CD instance %q is degraded: %s", instance.Name.ValueString(), argoInstance.GetHealthStatus().GetMessage())
+	}
+	return nil
+}
+
+// waitInstanceDeleted polls the Argo CD instance until the API no longer knows about it.
+func waitInstanceDeleted(ctx context.Context, client argocdv1.ArgoCDServiceGatewayClient, poll waiter.Config, orgID string, instance *types.Instance) error {
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
+		_, err := client.GetInstance(ctx, &argocdv1.GetInstanceRequest{
+			OrganizationId: orgID,
+			Id:             instance.ID.ValueString(),
+			IdType:         idv1.Type_ID,
+		})
+		if status.Code(err) == codes.NotFound {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to check deletion of Argo CD instance %q", instance.Name.ValueString())
+		}
+		return false, nil
+	})
+	if waiter.IsTimeout(err) {
+		return fmt.Errorf("timed out waiting for Argo CD instance %q to be deleted: %w", instance.Name.ValueString(), err)
+	}
+	return err
+}
+
+func buildApplyRequest(ctx context.Context, diagnostics *diag.Diagnostics, instance *types.Instance, orgID, workspaceID string) *argocdv1.ApplyInstanceRequest {
 	idType := idv1.Type_NAME
 	id := instance.Name.ValueString()
 
@@ -168,6 +300,7 @@ func buildApplyRequest(ctx context.Context, diagnostics *diag.Diagnostics, insta
 		OrganizationId:                orgID,
 		IdType:                        idType,
 		Id:                            id,
+		WorkspaceId:                   workspaceID,
 		Argocd:                        buildArgoCD(ctx, diagnostics, instance),
 		ArgocdConfigmap:               buildConfigMap(ctx, diagnostics, instance.ArgoCDConfigMap, "argocd-cm"),
 		ArgocdRbacConfigmap:           buildConfigMap(ctx, diagnostics, instance.ArgoCDRBACConfigMap, "argocd-rbac-cm"),
@@ -271,7 +404,7 @@ func buildCMPs(ctx context.Context, diagnostics *diag.Diagnostics, cmps map[stri
 	return res
 }
 
-func refreshState(ctx context.Context, diagnostics *diag.Diagnostics, client argocdv1.ArgoCDServiceGatewayClient, instance *types.Instance, orgID string) error {
+func refreshState(ctx context.Context, diagnostics *diag.Diagnostics, client argocdv1.ArgoCDServiceGatewayClient, orgc orgcv1.OrganizationServiceGatewayClient, instance *types.Instance, orgID string) error {
 	getInstanceReq := &argocdv1.GetInstanceRequest{
 		OrganizationId: orgID,
 		IdType:         idv1.Type_NAME,
@@ -284,10 +417,16 @@ func refreshState(ctx context.Context, diagnostics *diag.Diagnostics, client arg
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Get instance response: %s", getInstanceResp))
 	instance.ID = tftypes.StringValue(getInstanceResp.Instance.Id)
+	workspace, err := getWorkspace(ctx, orgc, orgID, getInstanceResp.Instance.WorkspaceId)
+	if err != nil {
+		return err
+	}
+	instance.Workspace = workspaceValue(workspace, instance.Workspace)
 	exportReq := &argocdv1.ExportInstanceRequest{
 		OrganizationId: orgID,
 		IdType:         idv1.Type_NAME,
 		Id:             instance.Name.ValueString(),
+		WorkspaceId:    getInstanceResp.Instance.WorkspaceId,
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Export instance request: %s", exportReq))
 	exportResp, err := client.ExportInstance(ctx, exportReq)
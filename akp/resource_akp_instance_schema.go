@@ -162,6 +162,25 @@ func getAKPInstanceAttributes() map[string]schema.Attribute {
 				Attributes: getAKPConfigManagementPluginAttributes(),
 			},
 		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "Workspace of the instance, given by name or ID. Defaults to the default workspace of the organization. Changing it moves the instance to the new workspace",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"wait_for_healthy": schema.BoolAttribute{
+			MarkdownDescription: "Wait for the instance to become healthy and reconciled after it is created or updated, default to `false`. The wait is bounded by the `create` and `update` timeouts",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"timeouts": schema.SingleNestedAttribute{
+			MarkdownDescription: "Timeouts for the instance operations, including waiting for the instance to become healthy",
+			Optional:            true,
+			Attributes:          getTimeoutsAttributes(),
+		},
 	}
 }
 
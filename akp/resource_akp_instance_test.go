@@ -0,0 +1,83 @@
+package akp
+
+import (
+	"context"
+	"testing"
+
+	hashitype "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	healthv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+func TestWaitInstanceHealthStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []*argocdv1.Instance
+		error    string
+	}{
+		{
+			name: "healthy after reconciliation",
+			statuses: []*argocdv1.Instance{
+				{
+					HealthStatus:         &healthv1.Status{Code: healthv1.StatusCode_STATUS_CODE_PROGRESSING},
+					ReconciliationStatus: &reconv1.Status{Code: reconv1.StatusCode_STATUS_CODE_PROGRESSING},
+				},
+				{
+					HealthStatus:         &healthv1.Status{Code: healthv1.StatusCode_STATUS_CODE_HEALTHY},
+					ReconciliationStatus: &reconv1.Status{Code: reconv1.StatusCode_STATUS_CODE_PROGRESSING},
+				},
+				{
+					HealthStatus:         &healthv1.Status{Code: healthv1.StatusCode_STATUS_CODE_HEALTHY},
+					ReconciliationStatus: &reconv1.Status{Code: reconv1.StatusCode_STATUS_CODE_SUCCESSFUL},
+				},
+			},
+		},
+		{
+			name: "degraded",
+			statuses: []*argocdv1.Instance{
+				{
+					HealthStatus:         &healthv1.Status{Code: healthv1.StatusCode_STATUS_CODE_DEGRADED, Message: "argocd-server is not available"},
+					ReconciliationStatus: &reconv1.Status{Code: reconv1.StatusCode_STATUS_CODE_SUCCESSFUL},
+				},
+			},
+			error: `Argo CD instance "test" is degraded: argocd-server is not available`,
+		},
+		{
+			name: "reconciliation failed",
+			statuses: []*argocdv1.Instance{
+				{
+					HealthStatus:         &healthv1.Status{Code: healthv1.StatusCode_STATUS_CODE_HEALTHY},
+					ReconciliationStatus: &reconv1.Status{Code: reconv1.StatusCode_STATUS_CODE_FAILED, Message: "invalid spec"},
+				},
+			},
+			error: `Argo CD instance "test" failed to reconcile: invalid spec`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := &fakeArgoCDClient{
+				getInstance: func(ctx context.Context, req *argocdv1.GetInstanceRequest) (*argocdv1.GetInstanceResponse, error) {
+					assert.Equal(t, "instance-id", req.Id)
+					instance := tt.statuses[calls]
+					calls++
+					return &argocdv1.GetInstanceResponse{Instance: instance}, nil
+				},
+			}
+			err := waitInstanceHealthStatus(context.Background(), client, testPollConfig, "org", &types.Instance{
+				ID:   hashitype.StringValue("instance-id"),
+				Name: hashitype.StringValue("test"),
+			})
+			if tt.error == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.error)
+			}
+			assert.Equal(t, len(tt.statuses), calls)
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -12,13 +11,19 @@ import (
 	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
 	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
 	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+	healthv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
 	"github.com/akuity/terraform-provider-akp/akp/types"
+	"github.com/akuity/terraform-provider-akp/akp/waiter"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -65,11 +70,15 @@ func (r *AkpKargoInstanceResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	err := r.upsert(ctx, &resp.Diagnostics, &plan)
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.CreateTimeout(defaultCreateTimeout))
+	defer cancel()
+	result, err := r.upsert(ctx, &resp.Diagnostics, &plan)
+	// Commit the state even if waiting for the instance to become healthy failed, the instance exists by then.
+	if result != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
-	} else {
-		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
 }
 
@@ -82,9 +91,11 @@ func (r *AkpKargoInstanceResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, data.Timeouts.ReadTimeout(defaultReadTimeout))
+	defer cancel()
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
 
-	err := refreshKargoState(ctx, &resp.Diagnostics, r.akpCli.KargoCli, &data, r.akpCli.OrgId)
+	err := refreshKargoState(ctx, &resp.Diagnostics, r.akpCli.KargoCli, r.akpCli.OrgCli, &data, r.akpCli.OrgId)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 	} else {
@@ -94,19 +105,28 @@ func (r *AkpKargoInstanceResource) Read(ctx context.Context, req resource.ReadRe
 
 func (r *AkpKargoInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, "Updating a Kargo instance")
-	var plan types.KargoInstance
+	var plan, state types.KargoInstance
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.upsert(ctx, &resp.Diagnostics, &plan)
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.UpdateTimeout(defaultUpdateTimeout))
+	defer cancel()
+	if err := r.moveWorkspace(ctx, &plan, &state); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	result, err := r.upsert(ctx, &resp.Diagnostics, &plan)
+	// Commit the state even if waiting for the instance to become healthy failed, the instance exists by then.
+	if result != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
-	} else {
-		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
 }
 
@@ -119,6 +139,8 @@ func (r *AkpKargoInstanceResource) Delete(ctx context.Context, req resource.Dele
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, state.Timeouts.DeleteTimeout(defaultDeleteTimeout))
+	defer cancel()
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
 	_, err := r.akpCli.KargoCli.DeleteInstance(ctx, &kargov1.DeleteInstanceRequest{
 		Id:             state.ID.ValueString(),
@@ -129,43 +151,134 @@ func (r *AkpKargoInstanceResource) Delete(ctx context.Context, req resource.Dele
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Argo CD instance, got error: %s", err))
 		return
 	}
-	// Give it some time to remove the Kargo instance. This is useful when the terraform provider is performing a replace operation, to give it enough time to destroy the previous instance.
-	time.Sleep(2 * time.Second)
+	// Wait until the Kargo instance is gone. This is useful when the terraform provider is performing a replace operation, to make sure the previous instance is destroyed.
+	if err := waitKargoInstanceDeleted(ctx, r.akpCli.KargoCli, r.akpCli.Poll, r.akpCli.OrgId, &state); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+	}
 }
 
+// ImportState imports a Kargo instance by `id:<id>`, `name:<name>`, or by name or ID, trying the name first.
 func (r *AkpKargoInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	instance, err := resolveKargoInstanceImport(ctx, r.akpCli.KargoCli, r.akpCli.OrgId, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), instance.GetId())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), instance.GetName())...)
+}
+
+func (r *AkpKargoInstanceResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, plan *types.KargoInstance) (*types.KargoInstance, error) {
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	workspace, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, plan.Workspace.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := buildKargoApplyRequest(ctx, diagnostics, plan, r.akpCli.OrgId, workspace.GetId())
+	if diagnostics.HasError() {
+		return nil, errors.New("Unable to build Kargo instance request")
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Apply instance request: %s", apiReq))
+	_, err = r.akpCli.KargoCli.ApplyKargoInstance(ctx, apiReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to upsert Kargo instance")
+	}
+
+	if err := refreshKargoState(ctx, diagnostics, r.akpCli.KargoCli, r.akpCli.OrgCli, plan, r.akpCli.OrgId); err != nil {
+		return nil, err
+	}
+	if plan.WaitForHealthy.ValueBool() {
+		return plan, waitKargoInstanceHealthStatus(ctx, r.akpCli.KargoCli, r.akpCli.Poll, r.akpCli.OrgId, plan)
+	}
+	return plan, nil
 }
 
-func (r *AkpKargoInstanceResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, plan *types.KargoInstance) error {
+// moveWorkspace moves an existing Kargo instance to the planned workspace when it differs from the one in state.
+func (r *AkpKargoInstanceResource) moveWorkspace(ctx context.Context, plan, state *types.KargoInstance) error {
+	if plan.Workspace.IsUnknown() || plan.Workspace.Equal(state.Workspace) {
+		return nil
+	}
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
-	workspaces, err := r.akpCli.OrgCli.ListWorkspaces(ctx, &orgcv1.ListWorkspacesRequest{
+	from, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, state.Workspace.ValueString())
+	if err != nil {
+		return err
+	}
+	to, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, plan.Workspace.ValueString())
+	if err != nil {
+		return err
+	}
+	if from.GetId() == to.GetId() {
+		return nil
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Moving Kargo instance %s from workspace %s to %s", state.ID.ValueString(), from.GetId(), to.GetId()))
+	_, err = r.akpCli.KargoCli.UpdateKargoInstanceWorkspace(ctx, &kargov1.UpdateKargoInstanceWorkspaceRequest{
 		OrganizationId: r.akpCli.OrgId,
+		Id:             state.ID.ValueString(),
+		WorkspaceId:    from.GetId(),
+		NewWorkspaceId: to.GetId(),
 	})
 	if err != nil {
-		return errors.Wrap(err, "Unable to read workspaces")
+		return errors.Wrap(err, "Unable to move Kargo instance to workspace")
 	}
-	var workspaceId string
-	for _, w := range workspaces.GetWorkspaces() {
-		if w.GetIsDefault() {
-			workspaceId = w.GetId()
-			break
+	return nil
+}
+
+// waitKargoInstanceHealthStatus polls the Kargo instance until it is reconciled and healthy.
+// A failed reconciliation or a degraded instance is reported as an error.
+func waitKargoInstanceHealthStatus(ctx context.Context, client kargov1.KargoServiceGatewayClient, poll waiter.Config, orgID string, kargo *types.KargoInstance) error {
+	var kargoInstance *kargov1.KargoInstance
+	breakStatusesHealth := []healthv1.StatusCode{healthv1.StatusCode_STATUS_CODE_HEALTHY, healthv1.StatusCode_STATUS_CODE_DEGRADED}
+	breakStatusesRecon := []reconv1.StatusCode{reconv1.StatusCode_STATUS_CODE_SUCCESSFUL, reconv1.StatusCode_STATUS_CODE_FAILED}
+
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
+		apiResp, err := client.GetKargoInstance(ctx, &kargov1.GetKargoInstanceRequest{
+			OrganizationId: orgID,
+			Name:           kargo.Name.ValueString(),
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to check health of Kargo instance %q, last health status: %s", kargo.Name.ValueString(), kargoInstance.GetHealthStatus().GetCode())
 		}
+		kargoInstance = apiResp.GetInstance()
+		tflog.Debug(ctx, fmt.Sprintf("Kargo instance health status: %s, recon status: %s", kargoInstance.GetHealthStatus().String(), kargoInstance.GetReconciliationStatus().String()))
+		return slices.Contains(breakStatusesRecon, kargoInstance.GetReconciliationStatus().GetCode()) &&
+			slices.Contains(breakStatusesHealth, kargoInstance.GetHealthStatus().GetCode()), nil
+	})
+	if waiter.IsTimeout(err) {
+		return fmt.Errorf("timed out waiting for Kargo instance %q to become healthy, last health status: %s, last reconciliation status: %s: %w",
+			kargo.Name.ValueString(), kargoInstance.GetHealthStatus().GetCode(), kargoInstance.GetReconciliationStatus().GetCode(), err)
 	}
-	if workspaceId == "" {
-		return errors.New("Default workspace not found")
+	if err != nil {
+		return err
 	}
-	apiReq := buildKargoApplyRequest(ctx, diagnostics, plan, r.akpCli.OrgId, workspaceId)
-	if diagnostics.HasError() {
-		return errors.New("Unable to build Kargo instance request")
+	if kargoInstance.GetReconciliationStatus().GetCode() == reconv1.StatusCode_STATUS_CODE_FAILED {
+		return fmt.Errorf("Kargo instance %q failed to reconcile: %s", kargo.Name.ValueString(), kargoInstance.GetReconciliationStatus().GetMessage())
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Apply instance request: %s", apiReq))
-	_, err = r.akpCli.KargoCli.ApplyKargoInstance(ctx, apiReq)
-	if err != nil {
-		return errors.Wrap(err, "Unable to upsert Kargo instance")
+	if kargoInstance.GetHealthStatus().GetCode() == healthv1.StatusCode_STATUS_CODE_DEGRADED {
+		return fmt.Errorf("Kargo instance %q is degraded: %s", kargo.Name.ValueString(), kargoInstance.GetHealthStatus().GetMessage())
 	}
+	return nil
+}
 
-	return refreshKargoState(ctx, diagnostics, r.akpCli.KargoCli, plan, r.akpCli.OrgId)
+// waitKargoInstanceDeleted polls the Kargo instance until the API no longer knows about it.
+func waitKargoInstanceDeleted(ctx context.Context, client kargov1.KargoServiceGatewayClient, poll waiter.Config, orgID string, kargo *types.KargoInstance) error {
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
+		_, err := client.GetKargoInstance(ctx, &kargov1.GetKargoInstanceRequest{
+			OrganizationId: orgID,
+			Name:           kargo.Name.ValueString(),
+		})
+		if status.Code(err) == codes.NotFound {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to check deletion of Kargo instance %q", kargo.Name.ValueString())
+		}
+		return false, nil
+	})
+	if waiter.IsTimeout(err) {
+		return fmt.Errorf("timed out waiting for Kargo instance %q to be deleted: %w", kargo.Name.ValueString(), err)
+	}
+	return err
 }
 
 func buildKargoApplyRequest(ctx context.Context, diagnostics *diag.Diagnostics, kargo *types.KargoInstance, orgID, workspaceID string) *kargov1.ApplyKargoInstanceRequest {
@@ -220,7 +333,7 @@ func buildKargo(ctx context.Context, diagnostics *diag.Diagnostics, kargo *types
 	return s
 }
 
-func refreshKargoState(ctx context.Context, diagnostics *diag.Diagnostics, client kargov1.KargoServiceGatewayClient, kargo *types.KargoInstance, orgID string) error {
+func refreshKargoState(ctx context.Context, diagnostics *diag.Diagnostics, client kargov1.KargoServiceGatewayClient, orgc orgcv1.OrganizationServiceGatewayClient, kargo *types.KargoInstance, orgID string) error {
 	req := &kargov1.GetKargoInstanceRequest{
 		OrganizationId: orgID,
 		Name:           kargo.Name.ValueString(),
@@ -232,6 +345,11 @@ func refreshKargoState(ctx context.Context, diagnostics *diag.Diagnostics, clien
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Get Kargo instance response: %s", resp))
 	kargo.ID = tftypes.StringValue(resp.Instance.Id)
+	workspace, err := getWorkspace(ctx, orgc, orgID, resp.Instance.WorkspaceId)
+	if err != nil {
+		return err
+	}
+	kargo.Workspace = workspaceValue(workspace, kargo.Workspace)
 	exportReq := &kargov1.ExportKargoInstanceRequest{
 		OrganizationId: orgID,
 		Id:             kargo.ID.ValueString(),
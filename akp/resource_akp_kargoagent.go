@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
@@ -22,6 +23,7 @@ import (
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
 	"github.com/akuity/terraform-provider-akp/akp/marshal"
 	"github.com/akuity/terraform-provider-akp/akp/types"
+	"github.com/akuity/terraform-provider-akp/akp/waiter"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -64,14 +66,24 @@ func (r *AkpKargoAgentResource) Create(ctx context.Context, req resource.CreateR
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// kube_config is write-only, it is only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("kube_config"), &plan.Kubeconfig)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := r.upsert(ctx, &resp.Diagnostics, &plan, true)
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.CreateTimeout(defaultCreateTimeout))
+	defer cancel()
+	installAgent := plan.Kubeconfig != nil
+	result, err := r.upsert(ctx, &resp.Diagnostics, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 	}
+	if installAgent {
+		// Remember that the agent was installed, so that destroying it fails rather than leaving it behind when the
+		// provider has no kube_config to remove it with.
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, agentInstalledKey, []byte("true"))...)
+	}
 	// In this case we commit state regardless whether there's an error or not. This is because there can be partial
 	// state (e.g. a cluster could be created in AKP but the manifests failed to be applied).
 	if result != nil {
@@ -88,8 +100,17 @@ func (r *AkpKargoAgentResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, data.Timeouts.ReadTimeout(defaultReadTimeout))
+	defer cancel()
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
 	err := refreshKargoAgentState(ctx, &resp.Diagnostics, r.akpCli.KargoCli, &data, r.akpCli.OrgId, &resp.State, &data)
+	if err == nil && resp.State.Raw.IsKnown() && !resp.State.Raw.IsNull() {
+		// The agent always lives in the workspace of its Kargo instance, which may have been moved.
+		var workspace *orgcv1.Workspace
+		if workspace, err = getKargoInstanceWorkspace(ctx, r.akpCli.KargoCli, r.akpCli.OrgCli, r.akpCli.OrgId, data.InstanceID.ValueString()); err == nil {
+			data.Workspace = workspaceValue(workspace, data.Workspace)
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 	} else {
@@ -103,14 +124,24 @@ func (r *AkpKargoAgentResource) Update(ctx context.Context, req resource.UpdateR
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// kube_config is write-only, it is only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("kube_config"), &plan.Kubeconfig)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := r.upsert(ctx, &resp.Diagnostics, &plan, false)
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.UpdateTimeout(defaultUpdateTimeout))
+	defer cancel()
+	installAgent := plan.Kubeconfig != nil
+	result, err := r.upsert(ctx, &resp.Diagnostics, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 	}
+	if installAgent {
+		// Remember that the agent was installed, so that destroying it fails rather than leaving it behind when the
+		// provider has no kube_config to remove it with.
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, agentInstalledKey, []byte("true"))...)
+	}
 	// In this case we commit state regardless whether there's an error or not. This is because there can be partial
 	// state (e.g. a cluster could be created in AKP but the manifests failed to be applied).
 	if result != nil {
@@ -128,23 +159,38 @@ func (r *AkpKargoAgentResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.DeleteTimeout(defaultDeleteTimeout))
+	defer cancel()
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
-	kubeconfig, err := getKubeconfig(plan.Kubeconfig)
+	// kube_config is write-only and not in the state, the agent is removed with the kube_config of the provider
+	kubeconfig, err := getKubeconfig(r.akpCli.KubeConfig)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
 
 	// Delete the manifests
+	owner := agentOwner(plan.InstanceID.ValueString(), plan.Name.ValueString())
+	if kubeconfig == nil && plan.RemoveAgentResourcesOnDestroy.ValueBool() {
+		agentInstalled, diags := req.Private.GetKey(ctx, agentInstalledKey)
+		resp.Diagnostics.Append(diags...)
+		addAgentNotRemovedDiagnostic(&resp.Diagnostics, agentInstalled != nil, owner)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	if kubeconfig != nil && plan.RemoveAgentResourcesOnDestroy.ValueBool() {
-		manifests, err := getKargoManifests(ctx, r.akpCli.KargoCli, r.akpCli.OrgId, &plan)
+		manifests, err := getKargoManifests(ctx, r.akpCli.KargoCli, r.akpCli.Poll, r.akpCli.OrgId, &plan)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", err.Error())
 			return
 		}
 
-		err = deleteManifests(ctx, manifests, kubeconfig)
-		if err != nil {
+		err = deleteManifests(ctx, manifests, kubeconfig, getParallelism(r.akpCli.KubeConfig), kargoAgentInventoryName, owner)
+		if errors.Is(err, errAgentNotInstalled) {
+			// The kube_config of the provider connects to a cluster another agent was installed in.
+			resp.Diagnostics.AddWarning("Agent Resources Not Removed", err.Error())
+		} else if err != nil {
 			resp.Diagnostics.AddError("Client Error", err.Error())
 			return
 		}
@@ -159,54 +205,59 @@ func (r *AkpKargoAgentResource) Delete(ctx context.Context, req resource.DeleteR
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Kargo agent. %s", err))
 		return
 	}
-	// Give it some time to remove the cluster. This is useful when the terraform provider is performing a replace operation, to give it enough time to destroy the previous cluster.
-	time.Sleep(5 * time.Second)
+	// Wait until the agent is gone. This is useful when the terraform provider is performing a replace operation, to make sure the previous agent is destroyed.
+	if err := waitKargoAgentDeleted(ctx, r.akpCli.KargoCli, r.akpCli.Poll, r.akpCli.OrgId, &plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+	}
 }
 
+// ImportState imports a Kargo agent by instance and Kargo agent name, i.e. instance/name.
+// The instance is given the same way the instance itself is imported: by name or ID, optionally prefixed with `name:` or `id:`.
 func (r *AkpKargoAgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: instance_id/name. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: instance_name/name or instance_id/name. Got: %q", req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[0])...)
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	instance, err := resolveKargoInstanceImport(ctx, r.akpCli.KargoCli, r.akpCli.OrgId, idParts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instance.GetId())...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
 }
 
-func (r *AkpKargoAgentResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, plan *types.KargoAgent, isCreate bool) (*types.KargoAgent, error) {
+func (r *AkpKargoAgentResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, plan *types.KargoAgent) (*types.KargoAgent, error) {
 	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
-	workspaces, err := r.akpCli.OrgCli.ListWorkspaces(ctx, &orgcv1.ListWorkspacesRequest{
-		OrganizationId: r.akpCli.OrgId,
-	})
+	workspace, err := getKargoInstanceWorkspace(ctx, r.akpCli.KargoCli, r.akpCli.OrgCli, r.akpCli.OrgId, plan.InstanceID.ValueString())
 	if err != nil {
-		return nil, errors.Wrap(err, "Unable to read workspaces")
+		return nil, err
 	}
-	var workspaceId string
-	for _, w := range workspaces.GetWorkspaces() {
-		if w.GetIsDefault() {
-			workspaceId = w.GetId()
-			break
-		}
-	}
-	if workspaceId == "" {
-		return nil, errors.New("Default workspace not found")
+	if !plan.Workspace.IsNull() && !plan.Workspace.IsUnknown() && !isWorkspace(workspace, plan.Workspace.ValueString()) {
+		diagnostics.AddAttributeError(path.Root("workspace"), "Invalid Workspace",
+			fmt.Sprintf("The Kargo instance %s is in workspace %q, not %q. Agents always live in the workspace of their Kargo instance, move the akp_kargo_instance to move them.",
+				plan.InstanceID.ValueString(), workspace.GetName(), plan.Workspace.ValueString()))
+		return nil, nil
 	}
-	apiReq := buildKargoAgentApplyRequest(ctx, diagnostics, plan, r.akpCli.OrgId, workspaceId)
+	plan.Workspace = workspaceValue(workspace, plan.Workspace)
+	apiReq := buildKargoAgentApplyRequest(ctx, diagnostics, plan, r.akpCli.OrgId, workspace.GetId())
 	if diagnostics.HasError() {
 		return nil, nil
 	}
-	result, err := r.applyKargoInstance(ctx, plan, apiReq, isCreate, r.akpCli.KargoCli.ApplyKargoInstance, r.upsertKubeConfig)
+	result, err := r.applyKargoInstance(ctx, plan, apiReq, r.akpCli.KargoCli.ApplyKargoInstance, r.upsertKubeConfig)
 	if err != nil {
 		return result, err
 	}
 	return result, refreshKargoAgentState(ctx, diagnostics, r.akpCli.KargoCli, result, r.akpCli.OrgId, nil, plan)
 }
 
-func (r *AkpKargoAgentResource) applyKargoInstance(ctx context.Context, plan *types.KargoAgent, apiReq *kargov1.ApplyKargoInstanceRequest, isCreate bool, applyKargoInstance func(context.Context, *kargov1.ApplyKargoInstanceRequest) (*kargov1.ApplyKargoInstanceResponse, error), upsertKubeConfig func(ctx context.Context, plan *types.KargoAgent, isCreate bool) error) (*types.KargoAgent, error) {
+func (r *AkpKargoAgentResource) applyKargoInstance(ctx context.Context, plan *types.KargoAgent, apiReq *kargov1.ApplyKargoInstanceRequest, applyKargoInstance func(context.Context, *kargov1.ApplyKargoInstanceRequest) (*kargov1.ApplyKargoInstanceResponse, error), upsertKubeConfig func(ctx context.Context, plan *types.KargoAgent) error) (*types.KargoAgent, error) {
 	kubeconfig := plan.Kubeconfig
 	plan.Kubeconfig = nil
 	tflog.Debug(ctx, fmt.Sprintf("Apply Kargo agent request: %s", apiReq))
@@ -217,10 +268,10 @@ func (r *AkpKargoAgentResource) applyKargoInstance(ctx context.Context, plan *ty
 
 	if kubeconfig != nil {
 		plan.Kubeconfig = kubeconfig
-		err = upsertKubeConfig(ctx, plan, isCreate)
+		err = upsertKubeConfig(ctx, plan)
+		// kube_config is write-only, ensure it won't be committed to state by setting it to nil
+		plan.Kubeconfig = nil
 		if err != nil {
-			// Ensure kubeconfig won't be committed to state by setting it to nil
-			plan.Kubeconfig = nil
 			return plan, fmt.Errorf("unable to apply manifests: %s", err)
 		}
 	}
@@ -228,27 +279,25 @@ func (r *AkpKargoAgentResource) applyKargoInstance(ctx context.Context, plan *ty
 	return plan, nil
 }
 
-func (r *AkpKargoAgentResource) upsertKubeConfig(ctx context.Context, plan *types.KargoAgent, isCreate bool) error {
-	// Apply agent manifests to clusters if the kubeconfig is specified for cluster.
+// upsertKubeConfig installs or upgrades the agent if the kubeconfig is specified for the Kargo agent, then waits for it to become healthy.
+func (r *AkpKargoAgentResource) upsertKubeConfig(ctx context.Context, plan *types.KargoAgent) error {
 	kubeconfig, err := getKubeconfig(plan.Kubeconfig)
 	if err != nil {
 		return err
 	}
+	if kubeconfig == nil {
+		return nil
+	}
 
-	// Apply the manifests
-	if kubeconfig != nil && isCreate {
-		manifests, err := getKargoManifests(ctx, r.akpCli.KargoCli, r.akpCli.OrgId, plan)
-		if err != nil {
-			return err
-		}
-
-		err = applyManifests(ctx, manifests, kubeconfig)
-		if err != nil {
-			return err
-		}
-		return waitKargoAgentHealthStatus(ctx, r.akpCli.KargoCli, r.akpCli.OrgId, plan)
+	manifests, err := getKargoManifests(ctx, r.akpCli.KargoCli, r.akpCli.Poll, r.akpCli.OrgId, plan)
+	if err != nil {
+		return err
 	}
-	return nil
+	owner := agentOwner(plan.InstanceID.ValueString(), plan.Name.ValueString())
+	if err = applyManifests(ctx, manifests, kubeconfig, getApplyOpts(plan.Kubeconfig), getParallelism(plan.Kubeconfig), kargoAgentInventoryName, owner); err != nil {
+		return err
+	}
+	return waitKargoAgentHealthStatus(ctx, r.akpCli.KargoCli, r.akpCli.Poll, r.akpCli.OrgId, plan)
 }
 
 func refreshKargoAgentState(ctx context.Context, diagnostics *diag.Diagnostics, client kargov1.KargoServiceGatewayClient, kargoAgent *types.KargoAgent,
@@ -298,7 +347,7 @@ func buildKargoAgents(ctx context.Context, diagnostics *diag.Diagnostics, kargoA
 	return cs
 }
 
-func getKargoManifests(ctx context.Context, client kargov1.KargoServiceGatewayClient, orgId string, kargoAgent *types.KargoAgent) (string, error) {
+func getKargoManifests(ctx context.Context, client kargov1.KargoServiceGatewayClient, poll waiter.Config, orgId string, kargoAgent *types.KargoAgent) (string, error) {
 	kargoAgentReq := &kargov1.GetKargoInstanceAgentRequest{
 		OrganizationId: orgId,
 		InstanceId:     kargoAgent.InstanceID.ValueString(),
@@ -308,7 +357,7 @@ func getKargoManifests(ctx context.Context, client kargov1.KargoServiceGatewayCl
 	if err != nil {
 		return "", errors.Wrap(err, "Unable to read instance kargo agent")
 	}
-	k, err := waitKargoAgentReconStatus(ctx, client, kargoAgentResp.GetAgent(), orgId, kargoAgent.InstanceID.ValueString())
+	k, err := waitKargoAgentReconStatus(ctx, client, poll, kargoAgentResp.GetAgent(), orgId, kargoAgent.InstanceID.ValueString())
 	if err != nil {
 		return "", errors.Wrap(err, "Unable to check kargo agent health status")
 	}
@@ -329,45 +378,75 @@ func getKargoManifests(ctx context.Context, client kargov1.KargoServiceGatewayCl
 	return string(res), nil
 }
 
-func waitKargoAgentHealthStatus(ctx context.Context, client kargov1.KargoServiceGatewayClient, orgID string, c *types.KargoAgent) error {
-	kargoAgent := &kargov1.KargoAgent{}
-	healthStatus := kargoAgent.GetHealthStatus()
+func waitKargoAgentHealthStatus(ctx context.Context, client kargov1.KargoServiceGatewayClient, poll waiter.Config, orgID string, c *types.KargoAgent) error {
+	var healthStatus *healthv1.Status
 	breakStatusesHealth := []healthv1.StatusCode{healthv1.StatusCode_STATUS_CODE_HEALTHY, healthv1.StatusCode_STATUS_CODE_DEGRADED}
 
-	for !slices.Contains(breakStatusesHealth, healthStatus.GetCode()) {
-		time.Sleep(1 * time.Second)
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
 		apiResp, err := client.GetKargoInstanceAgent(ctx, &kargov1.GetKargoInstanceAgentRequest{
 			OrganizationId: orgID,
 			InstanceId:     c.InstanceID.ValueString(),
 			Id:             c.Name.ValueString(),
 		})
 		if err != nil {
-			return err
+			return false, errors.Wrapf(err, "unable to check health of Kargo agent %q, last health status: %s", c.Name.ValueString(), healthStatus.GetCode())
 		}
-		kargoAgent = apiResp.GetAgent()
-		healthStatus = kargoAgent.GetHealthStatus()
+		healthStatus = apiResp.GetAgent().GetHealthStatus()
 		tflog.Debug(ctx, fmt.Sprintf("Kargo agent health status: %s", healthStatus.String()))
+		return slices.Contains(breakStatusesHealth, healthStatus.GetCode()), nil
+	})
+	if waiter.IsTimeout(err) {
+		return fmt.Errorf("timed out waiting for Kargo agent %q to become healthy, last health status: %s: %w", c.Name.ValueString(), healthStatus.GetCode(), err)
 	}
-	return nil
+	return err
 }
 
-func waitKargoAgentReconStatus(ctx context.Context, client kargov1.KargoServiceGatewayClient, kargoAgent *kargov1.KargoAgent, orgId, instanceId string) (*kargov1.KargoAgent, error) {
-	reconStatus := kargoAgent.GetReconciliationStatus()
+func waitKargoAgentReconStatus(ctx context.Context, client kargov1.KargoServiceGatewayClient, poll waiter.Config, kargoAgent *kargov1.KargoAgent, orgId, instanceId string) (*kargov1.KargoAgent, error) {
 	breakStatusesRecon := []reconv1.StatusCode{reconv1.StatusCode_STATUS_CODE_SUCCESSFUL, reconv1.StatusCode_STATUS_CODE_FAILED}
+	if slices.Contains(breakStatusesRecon, kargoAgent.GetReconciliationStatus().GetCode()) {
+		return kargoAgent, nil
+	}
 
-	for !slices.Contains(breakStatusesRecon, reconStatus.GetCode()) {
-		time.Sleep(1 * time.Second)
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
 		apiResp, err := client.GetKargoInstanceAgent(ctx, &kargov1.GetKargoInstanceAgentRequest{
 			OrganizationId: orgId,
 			InstanceId:     instanceId,
 			Id:             kargoAgent.Id,
 		})
 		if err != nil {
-			return nil, err
+			return false, errors.Wrapf(err, "unable to check reconciliation of Kargo agent %q, last reconciliation status: %s", kargoAgent.GetName(), kargoAgent.GetReconciliationStatus().GetCode())
 		}
 		kargoAgent = apiResp.GetAgent()
-		reconStatus = kargoAgent.GetReconciliationStatus()
-		tflog.Debug(ctx, fmt.Sprintf("Kargo agent recon status: %s", reconStatus.String()))
+		tflog.Debug(ctx, fmt.Sprintf("Kargo agent recon status: %s", kargoAgent.GetReconciliationStatus().String()))
+		return slices.Contains(breakStatusesRecon, kargoAgent.GetReconciliationStatus().GetCode()), nil
+	})
+	if waiter.IsTimeout(err) {
+		return nil, fmt.Errorf("timed out waiting for Kargo agent %q to reconcile, last reconciliation status: %s: %w", kargoAgent.GetName(), kargoAgent.GetReconciliationStatus().GetCode(), err)
+	}
+	if err != nil {
+		return nil, err
 	}
 	return kargoAgent, nil
 }
+
+// waitKargoAgentDeleted polls the Kargo agent until the API no longer knows about it.
+func waitKargoAgentDeleted(ctx context.Context, client kargov1.KargoServiceGatewayClient, poll waiter.Config, orgID string, c *types.KargoAgent) error {
+	err := waiter.Poll(ctx, poll, func(ctx context.Context) (bool, error) {
+		_, err := client.GetKargoInstanceAgent(ctx, &kargov1.GetKargoInstanceAgentRequest{
+			OrganizationId: orgID,
+			InstanceId:     c.InstanceID.ValueString(),
+			Id:             c.ID.ValueString(),
+		})
+		if status.Code(err) == codes.NotFound {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to check deletion of Kargo agent %q", c.Name.ValueString())
+		}
+		return false, nil
+	})
+	if waiter.IsTimeout(err) {
+		return fmt.Errorf("timed out waiting for Kargo agent %q to be deleted: %w", c.Name.ValueString(), err)
+	}
+	return err
+}
@@ -81,16 +81,27 @@ func getAKPKargoAgentResourceAttributes() map[string]schema.Attribute {
 			Attributes:          getAKPKargoAgentSpecAttributes(),
 		},
 		"kube_config": schema.SingleNestedAttribute{
-			MarkdownDescription: "Kubernetes connection settings. If configured, terraform will try to connect to the cluster and install the agent",
+			MarkdownDescription: "Kubernetes connection settings. If configured, terraform will try to connect to the cluster and install the agent, and upgrade it whenever the resource is updated. Agent resources that are no longer part of the upgraded manifests are removed, the applied resources are tracked in a ConfigMap in the agent namespace. Write-only, requires Terraform 1.11 or later: the credentials are never stored in the plan or the state, destroying the resource removes the agent with the `kube_config` of the provider",
 			Optional:            true,
+			WriteOnly:           true,
 			Attributes:          getKubeconfigAttributes(),
 		},
 		"remove_agent_resources_on_destroy": schema.BoolAttribute{
-			MarkdownDescription: "Remove agent Kubernetes resources from the managed cluster when destroying cluster, default to `true`",
+			MarkdownDescription: "Remove agent Kubernetes resources from the managed cluster when destroying cluster, default to `true`. The resources are removed with the `kube_config` of the provider, unless the inventory ConfigMap in that cluster records that another agent was installed there, in which case they are left in place with a warning. Destroying fails if Terraform installed the agent but the provider has no `kube_config`",
 			Optional:            true,
 			Computed:            true,
 			Default:             booldefault.StaticBool(true),
 		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "Workspace the agent belongs to, given by name or ID. Agents always live in the workspace of their Kargo instance, so it must be the workspace of `instance_id` when set. Move the `akp_kargo_instance` to move them",
+			Optional:            true,
+			Computed:            true,
+		},
+		"timeouts": schema.SingleNestedAttribute{
+			MarkdownDescription: "Timeouts for the Kargo agent operations, including waiting for the agent to become healthy",
+			Optional:            true,
+			Attributes:          getTimeoutsAttributes(),
+		},
 	}
 }
 
@@ -0,0 +1,274 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accesscontrolv1 "github.com/akuity/api-client-go/pkg/api/gen/accesscontrol/v1"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AkpOrganizationAPIKeyResource{}
+var _ resource.ResourceWithImportState = &AkpOrganizationAPIKeyResource{}
+var _ resource.ResourceWithModifyPlan = &AkpOrganizationAPIKeyResource{}
+
+func NewAkpOrganizationAPIKeyResource() resource.Resource {
+	return &AkpOrganizationAPIKeyResource{}
+}
+
+// AkpOrganizationAPIKeyResource defines the resource implementation.
+type AkpOrganizationAPIKeyResource struct {
+	akpCli *AkpCli
+}
+
+func (r *AkpOrganizationAPIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_api_key"
+}
+
+func (r *AkpOrganizationAPIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.akpCli = akpCli
+}
+
+// ModifyPlan marks the secret and expiration time as unknown when the secret is going to be rotated.
+func (r *AkpOrganizationAPIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state types.OrganizationAPIKey
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.RotationTrigger.Equal(state.RotationTrigger) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret"), tftypes.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expire_time"), tftypes.StringUnknown())...)
+}
+
+func (r *AkpOrganizationAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating an organization API key")
+	var plan types.OrganizationAPIKey
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	permissions := &accesscontrolv1.Permissions{Roles: []string{plan.Role.ValueString()}}
+	var apiKey *apikeyv1.APIKey
+	if plan.Workspace.IsNull() {
+		apiResp, err := r.akpCli.OrgCli.CreateOrganizationAPIKey(ctx, &orgcv1.CreateOrganizationAPIKeyRequest{
+			Id:               r.akpCli.OrgId,
+			Description:      plan.Description.ValueString(),
+			Permissions:      permissions,
+			ExpireInDuration: plan.Expiry.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organization API key, got error: %s", err))
+			return
+		}
+		apiKey = apiResp.GetApiKey()
+	} else {
+		workspace, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, plan.Workspace.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("workspace"), "Client Error", err.Error())
+			return
+		}
+		apiResp, err := r.akpCli.OrgCli.CreateWorkspaceAPIKey(ctx, &orgcv1.CreateWorkspaceAPIKeyRequest{
+			Id:               r.akpCli.OrgId,
+			WorkspaceId:      workspace.GetId(),
+			Description:      plan.Description.ValueString(),
+			Permissions:      permissions,
+			ExpireInDuration: plan.Expiry.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workspace API key, got error: %s", err))
+			return
+		}
+		apiKey = apiResp.GetApiKey()
+	}
+	plan.Update(apiKey)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpOrganizationAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading an organization API key")
+	var data types.OrganizationAPIKey
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	apiKey, err := r.getAPIKey(ctx, &data)
+	if status.Code(err) == codes.NotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	data.Update(apiKey)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only ever rotates the secret, every other change replaces the API key.
+func (r *AkpOrganizationAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating an organization API key")
+	var plan, state types.OrganizationAPIKey
+
+	// Read Terraform plan and prior state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	var apiKey *apikeyv1.APIKey
+	var err error
+	if plan.RotationTrigger.Equal(state.RotationTrigger) {
+		apiKey, err = r.getAPIKey(ctx, &plan)
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("Rotating the secret of API key %s", plan.ID.ValueString()))
+		apiKey, err = r.regenerateSecret(ctx, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	plan.Update(apiKey)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpOrganizationAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting an organization API key")
+	var state types.OrganizationAPIKey
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	var err error
+	if state.Workspace.IsNull() {
+		_, err = r.akpCli.APIKeyCli.DeleteAPIKey(ctx, &apikeyv1.DeleteAPIKeyRequest{
+			Id: state.ID.ValueString(),
+		})
+	} else {
+		var workspace *orgcv1.Workspace
+		workspace, err = getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, state.Workspace.ValueString())
+		if err == nil {
+			_, err = r.akpCli.APIKeyCli.DeleteWorkspaceAPIKey(ctx, &apikeyv1.DeleteWorkspaceAPIKeyRequest{
+				OrganizationId: r.akpCli.OrgId,
+				WorkspaceId:    workspace.GetId(),
+				Id:             state.ID.ValueString(),
+			})
+		}
+	}
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API key, got error: %s", err))
+	}
+}
+
+// ImportState imports an organization API key by ID, or a workspace API key by `workspace/id`.
+func (r *AkpOrganizationAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	switch {
+	case len(idParts) == 1 && idParts[0] != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	case len(idParts) == 2 && idParts[0] != "" && idParts[1] != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id or workspace/id. Got: %q", req.ID),
+		)
+	}
+}
+
+func (r *AkpOrganizationAPIKeyResource) getAPIKey(ctx context.Context, key *types.OrganizationAPIKey) (*apikeyv1.APIKey, error) {
+	if key.Workspace.IsNull() {
+		resp, err := r.akpCli.APIKeyCli.GetAPIKey(ctx, &apikeyv1.GetAPIKeyRequest{
+			Id: key.ID.ValueString(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to read API key")
+		}
+		return resp.GetApiKey(), nil
+	}
+	workspace, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, key.Workspace.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.akpCli.APIKeyCli.GetWorkspaceAPIKey(ctx, &apikeyv1.GetWorkspaceAPIKeyRequest{
+		OrganizationId: r.akpCli.OrgId,
+		WorkspaceId:    workspace.GetId(),
+		Id:             key.ID.ValueString(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read workspace API key")
+	}
+	return resp.GetApiKey(), nil
+}
+
+func (r *AkpOrganizationAPIKeyResource) regenerateSecret(ctx context.Context, key *types.OrganizationAPIKey) (*apikeyv1.APIKey, error) {
+	if key.Workspace.IsNull() {
+		resp, err := r.akpCli.APIKeyCli.RegenerateAPIKeySecret(ctx, &apikeyv1.RegenerateAPIKeySecretRequest{
+			Id:     key.ID.ValueString(),
+			Expiry: key.Expiry.ValueString(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to rotate API key secret")
+		}
+		return resp.GetApiKey(), nil
+	}
+	workspace, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, key.Workspace.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.akpCli.APIKeyCli.RegenerateWorkspaceAPIKeySecret(ctx, &apikeyv1.RegenerateWorkspaceAPIKeySecretRequest{
+		OrganizationId: r.akpCli.OrgId,
+		WorkspaceId:    workspace.GetId(),
+		Id:             key.ID.ValueString(),
+		Expiry:         key.Expiry.ValueString(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to rotate workspace API key secret")
+	}
+	return resp.GetApiKey(), nil
+}
@@ -0,0 +1,84 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *AkpOrganizationAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an API key of the organization, optionally scoped to a workspace. The Akuity Platform API does not scope API keys to a single Argo CD or Kargo instance, put the instance in a workspace of its own to limit an API key to it.",
+		Attributes:          getAKPOrganizationAPIKeyAttributes(),
+	}
+}
+
+func getAKPOrganizationAPIKeyAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "API key ID, used as `AKUITY_API_KEY_ID`",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "API key description. Changing it creates a new API key",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "Role granted to the API key, e.g. `organization/member`, or `workspace/member` for a key scoped to a workspace. Changing it creates a new API key",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"expiry": schema.StringAttribute{
+			MarkdownDescription: "Lifetime of the API key and of every rotated secret, e.g. `720h`. The API key does not expire when not set. Changing it creates a new API key",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "Workspace the API key is scoped to, given by name or ID. The API key is scoped to the whole organization when not set. Changing it creates a new API key",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rotation_trigger": schema.StringAttribute{
+			MarkdownDescription: "Arbitrary value that regenerates the secret of the API key in place whenever it changes, e.g. a date or a `time_rotating` ID",
+			Optional:            true,
+		},
+		"secret": schema.StringAttribute{
+			MarkdownDescription: "API key secret, used as `AKUITY_API_KEY_SECRET`. Only available for API keys created or rotated by Terraform, not for imported ones",
+			Computed:            true,
+			Sensitive:           true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"expire_time": schema.StringAttribute{
+			MarkdownDescription: "Expiration time of the API key in RFC 3339 format, empty if the API key does not expire",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package akp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationAPIKeyResource(t *testing.T) {
+	description := fmt.Sprintf("api-key-%s", acctest.RandString(10))
+	var secret string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccOrganizationAPIKeyResourceConfig(description, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("akp_organization_api_key.test", "id"),
+					resource.TestCheckResourceAttrSet("akp_organization_api_key.test", "secret"),
+					resource.TestCheckResourceAttrSet("akp_organization_api_key.test", "expire_time"),
+					resource.TestCheckResourceAttr("akp_organization_api_key.test", "description", description),
+					resource.TestCheckResourceAttr("akp_organization_api_key.test", "role", "organization/member"),
+					resource.TestCheckResourceAttrWith("akp_organization_api_key.test", "secret", func(value string) error {
+						secret = value
+						return nil
+					}),
+				),
+			},
+			// Rotation testing
+			{
+				Config: providerConfig + testAccOrganizationAPIKeyResourceConfig(description, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("akp_organization_api_key.test", "secret", func(value string) error {
+						if value == secret {
+							return fmt.Errorf("secret was not rotated")
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationAPIKeyResourceConfig(description, rotationTrigger string) string {
+	return fmt.Sprintf(`
+resource "akp_organization_api_key" "test" {
+  description      = %q
+  role             = "organization/member"
+  expiry           = "24h"
+  rotation_trigger = %q
+}
+`, description, rotationTrigger)
+}
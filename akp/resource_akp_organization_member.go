@@ -0,0 +1,212 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AkpOrganizationMemberResource{}
+var _ resource.ResourceWithImportState = &AkpOrganizationMemberResource{}
+
+func NewAkpOrganizationMemberResource() resource.Resource {
+	return &AkpOrganizationMemberResource{}
+}
+
+// AkpOrganizationMemberResource defines the resource implementation.
+type AkpOrganizationMemberResource struct {
+	akpCli *AkpCli
+}
+
+func (r *AkpOrganizationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+func (r *AkpOrganizationMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.akpCli = akpCli
+}
+
+func (r *AkpOrganizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating an organization member")
+	var plan types.OrganizationMember
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	if err := r.invite(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if err := refreshOrganizationMemberState(ctx, r.akpCli.OrgCli, &plan, r.akpCli.OrgId); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpOrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading an organization member")
+	var data types.OrganizationMember
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	err := refreshOrganizationMemberState(ctx, r.akpCli.OrgCli, &data, r.akpCli.OrgId)
+	if status.Code(err) == codes.NotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AkpOrganizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating an organization member")
+	var plan types.OrganizationMember
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	// The invitation may have been accepted since the last refresh, look the member up again.
+	member, pending, err := getOrganizationMember(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, plan.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if pending {
+		// The role of an invitation cannot be changed, the user is invited again with the new role.
+		if err := r.uninvite(ctx, member.GetEmail()); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		if err := r.invite(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	} else {
+		apiReq := &orgcv1.UpdateOrganizationMemberRoleRequest{
+			Id:       r.akpCli.OrgId,
+			MemberId: member.GetId(),
+			Role:     plan.Role.ValueString(),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Update organization member role request: %s", apiReq))
+		if _, err := r.akpCli.OrgCli.UpdateOrganizationMemberRole(ctx, apiReq); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization member role, got error: %s", err))
+			return
+		}
+	}
+	if err := refreshOrganizationMemberState(ctx, r.akpCli.OrgCli, &plan, r.akpCli.OrgId); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpOrganizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting an organization member")
+	var state types.OrganizationMember
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	member, pending, err := getOrganizationMember(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, state.Email.ValueString())
+	if status.Code(err) == codes.NotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if pending {
+		err = r.uninvite(ctx, member.GetEmail())
+	} else {
+		_, err = r.akpCli.OrgCli.RemoveOrganizationMember(ctx, &orgcv1.RemoveOrganizationMemberRequest{
+			Id:       r.akpCli.OrgId,
+			MemberId: member.GetId(),
+		})
+		err = errors.Wrap(err, "Unable to remove organization member")
+	}
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+	}
+}
+
+// ImportState imports an organization member, or a pending invitation, by email.
+func (r *AkpOrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+}
+
+// invite invites the user to the organization with the planned role.
+func (r *AkpOrganizationMemberResource) invite(ctx context.Context, plan *types.OrganizationMember) error {
+	apiReq := &orgcv1.InviteMembersRequest{
+		Id:     r.akpCli.OrgId,
+		Emails: []string{plan.Email.ValueString()},
+		Role:   plan.Role.ValueString(),
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Invite members request: %s", apiReq))
+	if _, err := r.akpCli.OrgCli.InviteMembers(ctx, apiReq); err != nil {
+		return errors.Wrap(err, "Unable to invite organization member")
+	}
+	return nil
+}
+
+// uninvite cancels the pending invitation of the user.
+func (r *AkpOrganizationMemberResource) uninvite(ctx context.Context, email string) error {
+	_, err := r.akpCli.OrgCli.UninviteOrganizationMember(ctx, &orgcv1.UninviteOrganizationMemberRequest{
+		Id:    r.akpCli.OrgId,
+		Email: email,
+	})
+	return errors.Wrap(err, "Unable to cancel organization invitation")
+}
+
+// refreshOrganizationMemberState reads the organization member, or the pending invitation, by email.
+func refreshOrganizationMemberState(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, member *types.OrganizationMember, orgID string) error {
+	apiMember, pending, err := getOrganizationMember(ctx, orgc, orgID, member.Email.ValueString())
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Organization member: %s, pending: %t", apiMember, pending))
+	member.Update(apiMember, pending)
+	return nil
+}
@@ -0,0 +1,54 @@
+package akp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationMemberResource(t *testing.T) {
+	email := fmt.Sprintf("member-%s@example.com", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccOrganizationMemberResourceConfig(email, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("akp_organization_member.test", "id"),
+					resource.TestCheckResourceAttr("akp_organization_member.test", "email", email),
+					resource.TestCheckResourceAttr("akp_organization_member.test", "role", "member"),
+					resource.TestCheckResourceAttr("akp_organization_member.test", "pending", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "akp_organization_member.test",
+				ImportState:       true,
+				ImportStateId:     email,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccOrganizationMemberResourceConfig(email, "admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("akp_organization_member.test", "role", "admin"),
+					resource.TestCheckResourceAttr("akp_organization_member.test", "pending", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationMemberResourceConfig(email, role string) string {
+	return fmt.Sprintf(`
+resource "akp_organization_member" "test" {
+  email = %q
+  role  = %q
+}
+`, email, role)
+}
@@ -0,0 +1,210 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AkpOrganizationSSOResource{}
+var _ resource.ResourceWithImportState = &AkpOrganizationSSOResource{}
+
+func NewAkpOrganizationSSOResource() resource.Resource {
+	return &AkpOrganizationSSOResource{}
+}
+
+// AkpOrganizationSSOResource defines the resource implementation.
+type AkpOrganizationSSOResource struct {
+	akpCli *AkpCli
+}
+
+func (r *AkpOrganizationSSOResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_sso"
+}
+
+func (r *AkpOrganizationSSOResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.akpCli = akpCli
+}
+
+func (r *AkpOrganizationSSOResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating an organization SSO configuration")
+	var plan types.OrganizationSSO
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	if err := r.upsert(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpOrganizationSSOResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading an organization SSO configuration")
+	var data types.OrganizationSSO
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	err := refreshOrganizationSSOState(ctx, r.akpCli.OrgCli, &data, r.akpCli.OrgId)
+	if status.Code(err) == codes.NotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AkpOrganizationSSOResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating an organization SSO configuration")
+	var plan types.OrganizationSSO
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	if err := r.upsert(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpOrganizationSSOResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting an organization SSO configuration")
+	var state types.OrganizationSSO
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	// Clear the managed mappings first, they would otherwise apply again as soon as SSO is configured anew.
+	if state.RoleMappings != nil {
+		if _, err := r.akpCli.OrgCli.UpdateOIDCMap(ctx, &orgcv1.UpdateOIDCMapRequest{Id: r.akpCli.OrgId}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear SSO role mappings, got error: %s", err))
+			return
+		}
+	}
+	if state.TeamMappings != nil {
+		if _, err := r.akpCli.OrgCli.UpdateTeamOIDCMap(ctx, &orgcv1.UpdateTeamOIDCMapRequest{OrganizationId: r.akpCli.OrgId}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear SSO team mappings, got error: %s", err))
+			return
+		}
+	}
+	_, err := r.akpCli.OrgCli.DeleteSSOConfiguration(ctx, &orgcv1.DeleteSSOConfigurationRequest{
+		Id: r.akpCli.OrgId,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SSO configuration, got error: %s", err))
+	}
+}
+
+// ImportState imports the SSO configuration of the organization the provider is configured for, the import ID is ignored.
+// The group mappings are imported as well and managed from then on.
+func (r *AkpOrganizationSSOResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.akpCli.OrgId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_mappings"), map[string]tftypes.String{})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_mappings"), map[string]tftypes.String{})...)
+}
+
+// upsert configures SSO and the group mappings as planned, then refreshes the state.
+// Mappings that are not set are left untouched.
+func (r *AkpOrganizationSSOResource) upsert(ctx context.Context, plan *types.OrganizationSSO) error {
+	apiReq := plan.ToEnsureRequest(r.akpCli.OrgId)
+	// Do not log the request, it contains the client secret.
+	if _, err := r.akpCli.OrgCli.EnsureSSOConfiguration(ctx, apiReq); err != nil {
+		return errors.Wrap(err, "Unable to configure SSO")
+	}
+	if plan.RoleMappings != nil {
+		apiReq := &orgcv1.UpdateOIDCMapRequest{
+			Id:      r.akpCli.OrgId,
+			Entries: types.ToStringMapAPIModel(plan.RoleMappings),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Update OIDC map request: %s", apiReq))
+		if _, err := r.akpCli.OrgCli.UpdateOIDCMap(ctx, apiReq); err != nil {
+			return errors.Wrap(err, "Unable to update SSO role mappings")
+		}
+	}
+	if plan.TeamMappings != nil {
+		apiReq := &orgcv1.UpdateTeamOIDCMapRequest{
+			OrganizationId: r.akpCli.OrgId,
+			Entries:        types.ToStringMapAPIModel(plan.TeamMappings),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Update team OIDC map request: %s", apiReq))
+		if _, err := r.akpCli.OrgCli.UpdateTeamOIDCMap(ctx, apiReq); err != nil {
+			return errors.Wrap(err, "Unable to update SSO team mappings")
+		}
+	}
+	return refreshOrganizationSSOState(ctx, r.akpCli.OrgCli, plan, r.akpCli.OrgId)
+}
+
+// refreshOrganizationSSOState reads the SSO configuration of the organization.
+// Group mappings are only read back if they are managed, i.e. not null.
+func refreshOrganizationSSOState(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, sso *types.OrganizationSSO, orgID string) error {
+	resp, err := orgc.GetSSOConfiguration(ctx, &orgcv1.GetSSOConfigurationRequest{
+		Id: orgID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Unable to read SSO configuration")
+	}
+	if resp.GetOidc() == nil && resp.GetSaml() == nil {
+		return status.Error(codes.NotFound, "SSO is not configured with OIDC or SAML")
+	}
+	sso.Update(orgID, resp)
+	if sso.RoleMappings != nil {
+		oidcMap, err := orgc.GetOIDCMap(ctx, &orgcv1.GetOIDCMapRequest{Id: orgID})
+		if err != nil {
+			return errors.Wrap(err, "Unable to read SSO role mappings")
+		}
+		sso.UpdateRoleMappings(oidcMap.GetEntries())
+	}
+	if sso.TeamMappings != nil {
+		teamOIDCMap, err := orgc.GetTeamOIDCMap(ctx, &orgcv1.GetTeamOIDCMapRequest{OrganizationId: orgID})
+		if err != nil {
+			return errors.Wrap(err, "Unable to read SSO team mappings")
+		}
+		sso.UpdateTeamMappings(teamOIDCMap.GetEntries())
+	}
+	return nil
+}
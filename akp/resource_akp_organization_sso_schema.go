@@ -0,0 +1,241 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+func (r *AkpOrganizationSSOResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the SSO configuration used to log in to the Akuity Platform organization. There is only one SSO configuration per organization.",
+		Attributes:          getAKPOrganizationSSOAttributes(),
+	}
+}
+
+func getAKPOrganizationSSOAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Organization ID",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"auto_add_member": schema.BoolAttribute{
+			MarkdownDescription: "Automatically add users who log in with SSO to the organization",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"oidc": schema.SingleNestedAttribute{
+			MarkdownDescription: "OIDC identity provider. Exactly one of `oidc` and `saml` must be set",
+			Optional:            true,
+			Attributes:          getAKPOrganizationOIDCAttributes(),
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(path.MatchRoot("saml")),
+			},
+		},
+		"saml": schema.SingleNestedAttribute{
+			MarkdownDescription: "SAML identity provider. Exactly one of `oidc` and `saml` must be set",
+			Optional:            true,
+			Attributes:          getAKPOrganizationSAMLAttributes(),
+		},
+		"role_mappings": schema.MapAttribute{
+			MarkdownDescription: "Maps groups of the identity provider to roles in the organization, e.g. `{ \"platform-admins\" = \"admin\" }`. When set, the mappings are managed exclusively by this resource. When not set, they are left untouched",
+			Optional:            true,
+			ElementType:         tftypes.StringType,
+		},
+		"team_mappings": schema.MapAttribute{
+			MarkdownDescription: "Maps groups of the identity provider to teams of the organization, e.g. `{ \"platform\" = akp_team.platform.name }`. When set, the mappings are managed exclusively by this resource. When not set, they are left untouched",
+			Optional:            true,
+			ElementType:         tftypes.StringType,
+		},
+	}
+}
+
+func getAKPOrganizationOIDCAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"issuer": schema.StringAttribute{
+			MarkdownDescription: "Issuer URL of the identity provider. Either `issuer` or `discovery_url` must be set",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("discovery_url")),
+			},
+		},
+		"discovery_url": schema.StringAttribute{
+			MarkdownDescription: "OpenID Connect discovery URL of the identity provider, used to look up its endpoints",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"client_id": schema.StringAttribute{
+			MarkdownDescription: "Client ID of the Akuity Platform application in the identity provider",
+			Required:            true,
+		},
+		"client_secret": schema.StringAttribute{
+			MarkdownDescription: "Client secret of the Akuity Platform application. When set, the authorization code flow is used, otherwise the implicit flow is used",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"authorization_endpoint": schema.StringAttribute{
+			MarkdownDescription: "Authorization endpoint of the identity provider, looked up from the discovery URL when not set",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"token_endpoint": schema.StringAttribute{
+			MarkdownDescription: "Token endpoint of the identity provider, looked up from the discovery URL when not set. Only used with `client_secret`",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"jwks_uri": schema.StringAttribute{
+			MarkdownDescription: "JSON Web Key Set URI of the identity provider, looked up from the discovery URL when not set",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"domain": schema.StringAttribute{
+			MarkdownDescription: "Email domain of the users allowed to log in with SSO, e.g. `example.com`",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"domain_aliases": schema.ListAttribute{
+			MarkdownDescription: "Additional email domains of the users allowed to log in with SSO",
+			Optional:            true,
+			ElementType:         tftypes.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"groups_scope_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Request the `groups` scope from the identity provider, required by `role_mappings` and `team_mappings` with most providers",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func getAKPOrganizationSAMLAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"domain": schema.StringAttribute{
+			MarkdownDescription: "Email domain of the users allowed to log in with SSO, e.g. `example.com`",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"domain_aliases": schema.ListAttribute{
+			MarkdownDescription: "Additional email domains of the users allowed to log in with SSO",
+			Optional:            true,
+			ElementType:         tftypes.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"metadata_xml": schema.StringAttribute{
+			MarkdownDescription: "SAML metadata XML of the identity provider. Exactly one of `metadata_xml` and `sign_in_endpoint` must be set",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("sign_in_endpoint")),
+			},
+		},
+		"sign_in_endpoint": schema.StringAttribute{
+			MarkdownDescription: "Sign-in endpoint of the identity provider",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sign_out_endpoint": schema.StringAttribute{
+			MarkdownDescription: "Sign-out endpoint of the identity provider",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"disable_sign_out": schema.BoolAttribute{
+			MarkdownDescription: "Do not sign out of the identity provider when signing out of the Akuity Platform",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"signing_cert": schema.StringAttribute{
+			MarkdownDescription: "Base64 encoded certificate the identity provider signs responses with",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sign_request": schema.BoolAttribute{
+			MarkdownDescription: "Sign the authentication requests sent to the identity provider",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"signature_algorithm": schema.StringAttribute{
+			MarkdownDescription: "Algorithm used to sign requests, one of `RSA-SHA256` or `RSA-SHA1`",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(types.SAMLSignatureAlgorithmRSASHA256, types.SAMLSignatureAlgorithmRSASHA1),
+			},
+		},
+		"digest_algorithm": schema.StringAttribute{
+			MarkdownDescription: "Digest algorithm used to sign requests, one of `SHA256` or `SHA1`",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(types.SAMLDigestAlgorithmSHA256, types.SAMLDigestAlgorithmSHA1),
+			},
+		},
+		"protocol_binding": schema.StringAttribute{
+			MarkdownDescription: "Binding used to send requests to the identity provider, one of `HTTP-Redirect` or `HTTP-POST`",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(types.SAMLProtocolBindingHTTPRedirect, types.SAMLProtocolBindingHTTPPost),
+			},
+		},
+	}
+}
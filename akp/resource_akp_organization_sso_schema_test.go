@@ -0,0 +1,20 @@
+//go:build !acc
+
+package akp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// If this test fails, a field has been added/removed to the OrganizationSSO related types.
+// Update the schema attribute accordingly.
+func TestNoNewOrganizationSSOFields(t *testing.T) {
+	assert.Equal(t, reflect.TypeOf(types.OrganizationSSO{}).NumField(), len(getAKPOrganizationSSOAttributes()))
+	assert.Equal(t, reflect.TypeOf(types.OrganizationOIDC{}).NumField(), len(getAKPOrganizationOIDCAttributes()))
+	assert.Equal(t, reflect.TypeOf(types.OrganizationSAML{}).NumField(), len(getAKPOrganizationSAMLAttributes()))
+}
@@ -0,0 +1,138 @@
+package akp
+
+import (
+	"fmt"
+	"testing"
+
+	hashitype "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+func TestAccOrganizationSSOResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccOrganizationSSOResourceConfig(false, "admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("akp_organization_sso.test", "id"),
+					resource.TestCheckResourceAttr("akp_organization_sso.test", "auto_add_member", "false"),
+					resource.TestCheckResourceAttr("akp_organization_sso.test", "oidc.issuer", "https://accounts.google.com"),
+					resource.TestCheckResourceAttr("akp_organization_sso.test", "oidc.domain", "example.com"),
+					resource.TestCheckResourceAttr("akp_organization_sso.test", "role_mappings.platform-admins", "admin"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "akp_organization_sso.test",
+				ImportState:             true,
+				ImportStateId:           "sso",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oidc.client_secret"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccOrganizationSSOResourceConfig(true, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("akp_organization_sso.test", "auto_add_member", "true"),
+					resource.TestCheckResourceAttr("akp_organization_sso.test", "role_mappings.platform-admins", "member"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationSSOResourceConfig(autoAddMember bool, role string) string {
+	return fmt.Sprintf(`
+resource "akp_organization_sso" "test" {
+  auto_add_member = %t
+  oidc = {
+    issuer               = "https://accounts.google.com"
+    client_id            = "test-client"
+    client_secret        = "test-secret"
+    domain               = "example.com"
+    groups_scope_enabled = true
+  }
+  role_mappings = {
+    "platform-admins" = %q
+  }
+}
+`, autoAddMember, role)
+}
+
+func TestOrganizationSSOEnsureRequest(t *testing.T) {
+	oidc := &types.OrganizationOIDC{
+		Issuer:       hashitype.StringValue("https://idp.example.com"),
+		ClientID:     hashitype.StringValue("client"),
+		ClientSecret: hashitype.StringNull(),
+		Domain:       hashitype.StringValue("example.com"),
+	}
+	sso := &types.OrganizationSSO{OIDC: oidc}
+	req := sso.ToEnsureRequest("org")
+	assert.Equal(t, "https://idp.example.com", req.GetOidc().GetFront().GetIssuer())
+	assert.Nil(t, req.GetOidc().GetBack())
+
+	oidc.ClientSecret = hashitype.StringValue("secret")
+	req = sso.ToEnsureRequest("org")
+	assert.Equal(t, "secret", req.GetOidc().GetBack().GetClientSecret())
+	assert.Equal(t, "https://idp.example.com", req.GetOidc().GetBack().GetIssuer())
+	assert.Nil(t, req.GetOidc().GetFront())
+
+	sso = &types.OrganizationSSO{SAML: &types.OrganizationSAML{
+		Domain:             hashitype.StringValue("example.com"),
+		MetadataXML:        hashitype.StringNull(),
+		SignInEndpoint:     hashitype.StringValue("https://idp.example.com/sso"),
+		SignOutEndpoint:    hashitype.StringUnknown(),
+		SignatureAlgorithm: hashitype.StringValue(types.SAMLSignatureAlgorithmRSASHA1),
+		ProtocolBinding:    hashitype.StringValue(types.SAMLProtocolBindingHTTPPost),
+	}}
+	req = sso.ToEnsureRequest("org")
+	details := req.GetSaml().GetConnectionDetails()
+	assert.Equal(t, "https://idp.example.com/sso", details.GetSignInEndpoint())
+	assert.Nil(t, details.SignOutEndpoint)
+	assert.Equal(t, orgcv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA1, details.GetSignatureAlgorithm())
+	assert.Equal(t, orgcv1.SAMLProtocolBinding_SAML_PROTOCOL_BINDING_HTTP_POST, details.GetProtocolBinding())
+}
+
+func TestOrganizationSSOUpdate(t *testing.T) {
+	sso := &types.OrganizationSSO{OIDC: &types.OrganizationOIDC{ClientSecret: hashitype.StringValue("secret")}}
+	sso.Update("org", &orgcv1.GetSSOConfigurationResponse{
+		AutoAddMember: true,
+		Options: &orgcv1.GetSSOConfigurationResponse_Oidc{Oidc: &orgcv1.OIDCSSOOptions{
+			ClientId: "client",
+			Domain:   "example.com",
+			Channel: &orgcv1.OIDCSSOOptions_Back{Back: &orgcv1.OIDCSSOBackChannel{
+				Issuer:        "https://idp.example.com",
+				TokenEndpoint: "https://idp.example.com/token",
+			}},
+		}},
+	})
+	assert.Equal(t, hashitype.StringValue("org"), sso.ID)
+	assert.Equal(t, hashitype.BoolValue(true), sso.AutoAddMember)
+	assert.Nil(t, sso.SAML)
+	assert.Equal(t, hashitype.StringValue("secret"), sso.OIDC.ClientSecret)
+	assert.Equal(t, hashitype.StringValue("https://idp.example.com"), sso.OIDC.Issuer)
+	assert.Equal(t, hashitype.StringValue("https://idp.example.com/token"), sso.OIDC.TokenEndpoint)
+	assert.Nil(t, sso.OIDC.DomainAliases)
+
+	sso = &types.OrganizationSSO{SAML: &types.OrganizationSAML{MetadataXML: hashitype.StringValue("<xml/>")}}
+	sso.Update("org", &orgcv1.GetSSOConfigurationResponse{
+		Options: &orgcv1.GetSSOConfigurationResponse_Saml{Saml: &orgcv1.SAMLSSOOptions{
+			Domain: "example.com",
+			Options: &orgcv1.SAMLSSOOptions_ConnectionDetails{ConnectionDetails: &orgcv1.SAMLSSOConnectionDetails{
+				SignInEndpoint: "https://idp.example.com/sso",
+			}},
+		}},
+	})
+	assert.Nil(t, sso.OIDC)
+	assert.Equal(t, hashitype.StringValue("<xml/>"), sso.SAML.MetadataXML)
+	assert.Equal(t, hashitype.StringValue("https://idp.example.com/sso"), sso.SAML.SignInEndpoint)
+	assert.Equal(t, hashitype.StringValue(""), sso.SAML.SignatureAlgorithm)
+}
@@ -0,0 +1,164 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AkpTeamResource{}
+var _ resource.ResourceWithImportState = &AkpTeamResource{}
+
+func NewAkpTeamResource() resource.Resource {
+	return &AkpTeamResource{}
+}
+
+// AkpTeamResource defines the resource implementation.
+type AkpTeamResource struct {
+	akpCli *AkpCli
+}
+
+func (r *AkpTeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *AkpTeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.akpCli = akpCli
+}
+
+func (r *AkpTeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating a team")
+	var plan types.Team
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	description := plan.Description.ValueString()
+	apiReq := &orgcv1.CreateTeamRequest{
+		OrganizationId: r.akpCli.OrgId,
+		Name:           plan.Name.ValueString(),
+		Description:    &description,
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Create team request: %s", apiReq))
+	apiResp, err := r.akpCli.OrgCli.CreateTeam(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team, got error: %s", err))
+		return
+	}
+	plan.Update(apiResp.GetUserTeam().GetTeam())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpTeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading a team")
+	var data types.Team
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	err := refreshTeamState(ctx, r.akpCli.OrgCli, &data, r.akpCli.OrgId)
+	if status.Code(err) == codes.NotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AkpTeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating a team")
+	var plan types.Team
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	apiReq := &orgcv1.UpdateTeamRequest{
+		OrganizationId: r.akpCli.OrgId,
+		Name:           plan.Name.ValueString(),
+		Description:    plan.Description.ValueString(),
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Update team request: %s", apiReq))
+	apiResp, err := r.akpCli.OrgCli.UpdateTeam(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team, got error: %s", err))
+		return
+	}
+	plan.Update(apiResp.GetUserTeam().GetTeam())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpTeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting a team")
+	var state types.Team
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	_, err := r.akpCli.OrgCli.DeleteTeam(ctx, &orgcv1.DeleteTeamRequest{
+		OrganizationId: r.akpCli.OrgId,
+		Name:           state.Name.ValueString(),
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team, got error: %s", err))
+	}
+}
+
+// ImportState imports a team by name.
+func (r *AkpTeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// refreshTeamState reads the team by name.
+func refreshTeamState(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, team *types.Team, orgID string) error {
+	resp, err := orgc.GetTeam(ctx, &orgcv1.GetTeamRequest{
+		OrganizationId: orgID,
+		Name:           team.Name.ValueString(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Unable to read team")
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Get team response: %s", resp))
+	team.Update(resp.GetUserTeam().GetTeam())
+	return nil
+}
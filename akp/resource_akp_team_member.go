@@ -0,0 +1,185 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AkpTeamMemberResource{}
+var _ resource.ResourceWithImportState = &AkpTeamMemberResource{}
+
+func NewAkpTeamMemberResource() resource.Resource {
+	return &AkpTeamMemberResource{}
+}
+
+// AkpTeamMemberResource defines the resource implementation.
+type AkpTeamMemberResource struct {
+	akpCli *AkpCli
+}
+
+func (r *AkpTeamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *AkpTeamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.akpCli = akpCli
+}
+
+func (r *AkpTeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating a team member")
+	var plan types.TeamMember
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	userID, err := getTeamMemberUserID(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, plan.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	apiReq := &orgcv1.AddTeamMemberRequest{
+		OrganizationId: r.akpCli.OrgId,
+		TeamName:       plan.Team.ValueString(),
+		UserId:         userID,
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Add team member request: %s", apiReq))
+	apiResp, err := r.akpCli.OrgCli.AddTeamMember(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add team member, got error: %s", err))
+		return
+	}
+	plan.Update(plan.Team.ValueString(), apiResp.GetTeamMember())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpTeamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading a team member")
+	var data types.TeamMember
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	err := refreshTeamMemberState(ctx, r.akpCli.OrgCli, &data, r.akpCli.OrgId)
+	if status.Code(err) == codes.NotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with actual changes since every attribute requires replacement.
+func (r *AkpTeamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan types.TeamMember
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpTeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting a team member")
+	var state types.TeamMember
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	_, err := r.akpCli.OrgCli.RemoveTeamMember(ctx, &orgcv1.RemoveTeamMemberRequest{
+		OrganizationId: r.akpCli.OrgId,
+		TeamName:       state.Team.ValueString(),
+		Id:             state.UserID.ValueString(),
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove team member, got error: %s", err))
+	}
+}
+
+// ImportState imports a team member by team name and email, i.e. team/email.
+func (r *AkpTeamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team/email. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), idParts[1])...)
+}
+
+// getTeamMemberUserID returns the user ID of the organization member with the given email.
+// Only users who have accepted the invitation to the organization can be added to a team.
+func getTeamMemberUserID(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, orgID, email string) (string, error) {
+	member, pending, err := getOrganizationMember(ctx, orgc, orgID, email)
+	if err != nil {
+		return "", err
+	}
+	if pending {
+		return "", fmt.Errorf("User %q has not accepted the invitation to the organization yet", email)
+	}
+	return member.GetId(), nil
+}
+
+// refreshTeamMemberState reads the team member. The user ID is looked up by email when it is not known yet, e.g. on import.
+func refreshTeamMemberState(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, member *types.TeamMember, orgID string) error {
+	if member.UserID.IsNull() || member.UserID.IsUnknown() {
+		userID, err := getTeamMemberUserID(ctx, orgc, orgID, member.Email.ValueString())
+		if err != nil {
+			return err
+		}
+		member.UserID = tftypes.StringValue(userID)
+	}
+	resp, err := orgc.GetTeamMember(ctx, &orgcv1.GetTeamMemberRequest{
+		OrganizationId: orgID,
+		TeamName:       member.Team.ValueString(),
+		Id:             member.UserID.ValueString(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Unable to read team member")
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Get team member response: %s", resp))
+	member.Update(member.Team.ValueString(), resp.GetTeamMember())
+	return nil
+}
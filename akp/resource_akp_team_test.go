@@ -0,0 +1,53 @@
+package akp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamResource(t *testing.T) {
+	name := fmt.Sprintf("team-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccTeamResourceConfig(name, "test one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("akp_team.test", "id", name),
+					resource.TestCheckResourceAttr("akp_team.test", "name", name),
+					resource.TestCheckResourceAttr("akp_team.test", "description", "test one"),
+					resource.TestCheckResourceAttr("akp_team.test", "member_count", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "akp_team.test",
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccTeamResourceConfig(name, "test two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("akp_team.test", "description", "test two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamResourceConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "akp_team" "test" {
+  name        = %q
+  description = %q
+}
+`, name, description)
+}
@@ -0,0 +1,215 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AkpWorkspaceResource{}
+var _ resource.ResourceWithImportState = &AkpWorkspaceResource{}
+
+func NewAkpWorkspaceResource() resource.Resource {
+	return &AkpWorkspaceResource{}
+}
+
+// AkpWorkspaceResource defines the resource implementation.
+type AkpWorkspaceResource struct {
+	akpCli *AkpCli
+}
+
+func (r *AkpWorkspaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+func (r *AkpWorkspaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	akpCli, ok := req.ProviderData.(*AkpCli)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AkpCli, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.akpCli = akpCli
+}
+
+func (r *AkpWorkspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating a workspace")
+	var plan types.Workspace
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	description := plan.Description.ValueString()
+	apiReq := &orgcv1.CreateWorkspaceRequest{
+		OrganizationId: r.akpCli.OrgId,
+		Name:           plan.Name.ValueString(),
+		Description:    &description,
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Create workspace request: %s", apiReq))
+	apiResp, err := r.akpCli.OrgCli.CreateWorkspace(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workspace, got error: %s", err))
+		return
+	}
+	plan.Update(apiResp.GetWorkspace())
+	// Commit the workspace to state right away so that it is not leaked if updating its members fails.
+	members := plan.Members
+	plan.Members = nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || members == nil {
+		return
+	}
+	plan.Members = members
+
+	if err := r.updateMembers(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpWorkspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading a workspace")
+	var data types.Workspace
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	err := refreshWorkspaceState(ctx, r.akpCli.OrgCli, &data, r.akpCli.OrgId)
+	if status.Code(err) == codes.NotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AkpWorkspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating a workspace")
+	var plan types.Workspace
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	description := plan.Description.ValueString()
+	apiReq := &orgcv1.UpdateWorkspaceRequest{
+		OrganizationId: r.akpCli.OrgId,
+		Id:             plan.ID.ValueString(),
+		Name:           plan.Name.ValueString(),
+		Description:    &description,
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Update workspace request: %s", apiReq))
+	apiResp, err := r.akpCli.OrgCli.UpdateWorkspace(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workspace, got error: %s", err))
+		return
+	}
+	plan.Update(apiResp.GetWorkspace())
+	if plan.Members != nil {
+		if err := r.updateMembers(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AkpWorkspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting a workspace")
+	var state types.Workspace
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	_, err := r.akpCli.OrgCli.DeleteWorkspace(ctx, &orgcv1.DeleteWorkspaceRequest{
+		OrganizationId: r.akpCli.OrgId,
+		Id:             state.ID.ValueString(),
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workspace, got error: %s", err))
+	}
+}
+
+// ImportState imports a workspace by ID or name.
+func (r *AkpWorkspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = httpctx.SetAuthorizationHeader(ctx, r.akpCli.Cred.Scheme(), r.akpCli.Cred.Credential())
+	workspace, err := getWorkspace(ctx, r.akpCli.OrgCli, r.akpCli.OrgId, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), workspace.GetId())...)
+	// Members are only read back when they are managed, import them so that the import can be verified by a plan.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("members"), []types.WorkspaceMember{})...)
+}
+
+// updateMembers replaces the members of the workspace with the planned ones.
+func (r *AkpWorkspaceResource) updateMembers(ctx context.Context, plan *types.Workspace) error {
+	apiReq := &orgcv1.UpdateWorkspaceMembersRequest{
+		OrganizationId: r.akpCli.OrgId,
+		WorkspaceId:    plan.ID.ValueString(),
+		MemberRefs:     plan.ToMemberRefs(),
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Update workspace members request: %s", apiReq))
+	if _, err := r.akpCli.OrgCli.UpdateWorkspaceMembers(ctx, apiReq); err != nil {
+		return errors.Wrap(err, "Unable to update workspace members")
+	}
+	return nil
+}
+
+// refreshWorkspaceState reads the workspace by ID. Members are only read back if they are managed, i.e. not null.
+func refreshWorkspaceState(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, workspace *types.Workspace, orgID string) error {
+	resp, err := orgc.GetWorkspace(ctx, &orgcv1.GetWorkspaceRequest{
+		OrganizationId: orgID,
+		Id:             workspace.ID.ValueString(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Unable to read workspace")
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Get workspace response: %s", resp))
+	workspace.Update(resp.GetWorkspace())
+	if workspace.Members == nil {
+		return nil
+	}
+	members, err := listWorkspaceMembers(ctx, orgc, orgID, workspace.ID.ValueString())
+	if err != nil {
+		return err
+	}
+	workspace.UpdateMembers(members)
+	return nil
+}
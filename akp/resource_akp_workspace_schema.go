@@ -0,0 +1,86 @@
+package akp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+func (r *AkpWorkspaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a workspace of the organization.",
+		Attributes:          getAKPWorkspaceAttributes(),
+	}
+}
+
+func getAKPWorkspaceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Workspace ID",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Workspace name",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Workspace description",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"is_default": schema.BoolAttribute{
+			MarkdownDescription: "Whether this is the default workspace of the organization",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"members": schema.SetNestedAttribute{
+			MarkdownDescription: "Users and teams that are members of the workspace. When set, the membership of the workspace is managed exclusively by this resource, members not listed here are removed. When not set, the membership is left untouched",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: getAKPWorkspaceMemberAttributes(),
+			},
+		},
+	}
+}
+
+func getAKPWorkspaceMemberAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"user_email": schema.StringAttribute{
+			MarkdownDescription: "Email of the user. Exactly one of `user_email` and `team_name` must be set",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("team_name")),
+			},
+		},
+		"team_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the team. Exactly one of `user_email` and `team_name` must be set",
+			Optional:            true,
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "Role of the member in the workspace, one of `member` or `admin`",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(types.WorkspaceRoleMember, types.WorkspaceRoleAdmin),
+			},
+		},
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !acc
+
+package akp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+// If this test fails, a field has been added/removed to the Workspace related types.
+// Update the schema attribute accordingly.
+func TestNoNewWorkspaceFields(t *testing.T) {
+	assert.Equal(t, reflect.TypeOf(types.Workspace{}).NumField(), len(getAKPWorkspaceAttributes()))
+	assert.Equal(t, reflect.TypeOf(types.WorkspaceMember{}).NumField(), len(getAKPWorkspaceMemberAttributes()))
+}
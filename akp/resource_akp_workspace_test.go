@@ -0,0 +1,111 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/akuity/api-client-go/pkg/api/gateway/accesscontrol"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/akuity/terraform-provider-akp/akp/types"
+)
+
+func TestAccWorkspaceResource(t *testing.T) {
+	name := fmt.Sprintf("workspace-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccWorkspaceResourceConfig(name, "test one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("akp_workspace.test", "id"),
+					resource.TestCheckResourceAttr("akp_workspace.test", "name", name),
+					resource.TestCheckResourceAttr("akp_workspace.test", "description", "test one"),
+					resource.TestCheckResourceAttr("akp_workspace.test", "is_default", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "akp_workspace.test",
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+				// Import reads the members back, the configuration leaves them unmanaged.
+				ImportStateVerifyIgnore: []string{"members"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccWorkspaceResourceConfig(name+"-renamed", "test two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("akp_workspace.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("akp_workspace.test", "description", "test two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceResourceConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "akp_workspace" "test" {
+  name        = %q
+  description = %q
+}
+`, name, description)
+}
+
+func TestListWorkspaceMembers(t *testing.T) {
+	var all []*orgcv1.WorkspaceMember
+	for i := 0; i < workspaceMembersPageSize+1; i++ {
+		all = append(all, &orgcv1.WorkspaceMember{Id: fmt.Sprintf("member-%d", i)})
+	}
+	calls := 0
+	client := &fakeOrgClient{
+		listWorkspaceMembers: func(ctx context.Context, req *orgcv1.ListWorkspaceMembersRequest) (*orgcv1.ListWorkspaceMembersResponse, error) {
+			calls++
+			assert.Equal(t, "ws-1", req.WorkspaceId)
+			end := min(int(req.GetOffset()+req.GetLimit()), len(all))
+			return &orgcv1.ListWorkspaceMembersResponse{WorkspaceMembers: all[req.GetOffset():end]}, nil
+		},
+	}
+	members, err := listWorkspaceMembers(context.Background(), client, "org", "ws-1")
+	assert.NoError(t, err)
+	assert.Equal(t, all, members)
+	assert.Equal(t, 2, calls)
+}
+
+func TestAkpWorkspaceResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &AkpWorkspaceResource{akpCli: &AkpCli{
+		Cred:   accesscontrol.NewAPIKeyCredential("id", "secret"),
+		OrgId:  "org",
+		OrgCli: &fakeOrgClient{workspaces: []*orgcv1.Workspace{{Id: "ws-1", Name: "team-a"}}},
+	}}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	resp := &fwresource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "team-a"}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state types.Workspace
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "ws-1", state.ID.ValueString())
+	// Members must not be null, otherwise they are never read back.
+	assert.NotNil(t, state.Members)
+	assert.Empty(t, state.Members)
+}
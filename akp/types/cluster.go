@@ -20,6 +20,7 @@ type Cluster struct {
 	Spec                          *ClusterSpec `tfsdk:"spec"`
 	Kubeconfig                    *Kubeconfig  `tfsdk:"kube_config"`
 	RemoveAgentResourcesOnDestroy types.Bool   `tfsdk:"remove_agent_resources_on_destroy"`
+	Timeouts                      *Timeouts    `tfsdk:"timeouts"`
 }
 
 type Clusters struct {
@@ -31,6 +31,16 @@ type Instance struct {
 	RepoCredentialSecrets         types.Map                          `tfsdk:"repo_credential_secrets"`
 	RepoTemplateCredentialSecrets types.Map                          `tfsdk:"repo_template_credential_secrets"`
 	ConfigManagementPlugins       map[string]*ConfigManagementPlugin `tfsdk:"config_management_plugins"`
+	Workspace                     types.String                       `tfsdk:"workspace"`
+	WaitForHealthy                types.Bool                         `tfsdk:"wait_for_healthy"`
+	Timeouts                      *Timeouts                          `tfsdk:"timeouts"`
+}
+
+type Instances struct {
+	ID        types.String `tfsdk:"id"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Workspace types.String `tfsdk:"workspace"`
+	Instances []Instance   `tfsdk:"instances"`
 }
 
 func (i *Instance) GetSensitiveStrings(ctx context.Context, diagnostics *diag.Diagnostics) []string {
@@ -20,6 +20,16 @@ type KargoInstance struct {
 	Kargo          *Kargo       `tfsdk:"kargo"`
 	KargoConfigMap types.Map    `tfsdk:"kargo_cm"`
 	KargoSecret    types.Map    `tfsdk:"kargo_secret"`
+	Workspace      types.String `tfsdk:"workspace"`
+	WaitForHealthy types.Bool   `tfsdk:"wait_for_healthy"`
+	Timeouts       *Timeouts    `tfsdk:"timeouts"`
+}
+
+type KargoInstances struct {
+	ID        types.String    `tfsdk:"id"`
+	NameRegex types.String    `tfsdk:"name_regex"`
+	Workspace types.String    `tfsdk:"workspace"`
+	Instances []KargoInstance `tfsdk:"instances"`
 }
 
 func (k *KargoInstance) Update(ctx context.Context, diagnostics *diag.Diagnostics, exportResp *kargov1.ExportKargoInstanceResponse) error {
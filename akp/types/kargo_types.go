@@ -240,7 +240,8 @@ func (ka *KargoAgent) Update(ctx context.Context, diagnostics *diag.Diagnostics,
 	ka.Annotations = annotations
 
 	argocdNs := apiKargoAgent.GetData().GetArgocdNamespace()
-	if apiKargoAgent.GetData().GetRemoteArgocd() == "" && !apiKargoAgent.GetData().GetAkuityManaged() {
+	// Self-hosted agents keep the planned Argo CD namespace, without a plan (e.g. on import) the one returned by the API is used.
+	if apiKargoAgent.GetData().GetRemoteArgocd() == "" && !apiKargoAgent.GetData().GetAkuityManaged() && plan != nil && plan.Spec != nil {
 		argocdNs = plan.Spec.Data.ArgocdNamespace.ValueString()
 	}
 
@@ -19,6 +19,8 @@ type KargoAgent struct {
 	Spec                          *KargoAgentSpec `tfsdk:"spec"`
 	Kubeconfig                    *Kubeconfig     `tfsdk:"kube_config"`
 	RemoveAgentResourcesOnDestroy types.Bool      `tfsdk:"remove_agent_resources_on_destroy"`
+	Workspace                     types.String    `tfsdk:"workspace"`
+	Timeouts                      *Timeouts       `tfsdk:"timeouts"`
 }
 
 type KargoAgents struct {
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AgentManifests are the install manifests of the agent of a cluster or of a Kargo agent.
+type AgentManifests struct {
+	InstanceID types.String   `tfsdk:"instance_id"`
+	Name       types.String   `tfsdk:"name"`
+	Manifests  types.String   `tfsdk:"manifests"`
+	Documents  []types.String `tfsdk:"documents"`
+}
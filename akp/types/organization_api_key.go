@@ -0,0 +1,40 @@
+package types
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+)
+
+type OrganizationAPIKey struct {
+	ID              types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Role            types.String `tfsdk:"role"`
+	Expiry          types.String `tfsdk:"expiry"`
+	Workspace       types.String `tfsdk:"workspace"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	Secret          types.String `tfsdk:"secret"`
+	ExpireTime      types.String `tfsdk:"expire_time"`
+}
+
+// Update sets the state from the API key. The secret is only returned when the key is created or regenerated,
+// so the current secret is kept otherwise.
+func (k *OrganizationAPIKey) Update(apiKey *apikeyv1.APIKey) {
+	k.ID = types.StringValue(apiKey.GetId())
+	k.Description = types.StringValue(apiKey.GetDescription())
+	if roles := apiKey.GetPermissions().GetRoles(); len(roles) > 0 {
+		k.Role = types.StringValue(roles[0])
+	}
+	if apiKey.Secret != nil {
+		k.Secret = types.StringValue(apiKey.GetSecret())
+	}
+	if k.Secret.IsUnknown() {
+		k.Secret = types.StringNull()
+	}
+	k.ExpireTime = types.StringValue("")
+	if apiKey.GetExpireTime() != nil {
+		k.ExpireTime = types.StringValue(apiKey.GetExpireTime().AsTime().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+)
+
+type OrganizationMember struct {
+	ID      types.String `tfsdk:"id"`
+	Email   types.String `tfsdk:"email"`
+	Role    types.String `tfsdk:"role"`
+	Pending types.Bool   `tfsdk:"pending"`
+}
+
+// Update sets the state from the organization member. Pending members are invitations that have not been accepted yet.
+// Emails are case-insensitive, the current email is kept when it only differs by case.
+func (m *OrganizationMember) Update(member *orgcv1.OrganizationMember, pending bool) {
+	m.ID = types.StringValue(member.GetId())
+	if !strings.EqualFold(m.Email.ValueString(), member.GetEmail()) {
+		m.Email = types.StringValue(member.GetEmail())
+	}
+	m.Role = types.StringValue(member.GetRole())
+	m.Pending = types.BoolValue(pending)
+}
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+)
+
+const (
+	SAMLSignatureAlgorithmRSASHA256 = "RSA-SHA256"
+	SAMLSignatureAlgorithmRSASHA1   = "RSA-SHA1"
+	SAMLDigestAlgorithmSHA256       = "SHA256"
+	SAMLDigestAlgorithmSHA1         = "SHA1"
+	SAMLProtocolBindingHTTPRedirect = "HTTP-Redirect"
+	SAMLProtocolBindingHTTPPost     = "HTTP-POST"
+)
+
+var samlSignatureAlgorithms = map[string]orgcv1.SAMLSignatureAlgorithm{
+	SAMLSignatureAlgorithmRSASHA256: orgcv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256,
+	SAMLSignatureAlgorithmRSASHA1:   orgcv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA1,
+}
+
+var samlDigestAlgorithms = map[string]orgcv1.SAMLDigestAlgorithm{
+	SAMLDigestAlgorithmSHA256: orgcv1.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA256,
+	SAMLDigestAlgorithmSHA1:   orgcv1.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA1,
+}
+
+var samlProtocolBindings = map[string]orgcv1.SAMLProtocolBinding{
+	SAMLProtocolBindingHTTPRedirect: orgcv1.SAMLProtocolBinding_SAML_PROTOCOL_BINDING_HTTP_REDIRECT,
+	SAMLProtocolBindingHTTPPost:     orgcv1.SAMLProtocolBinding_SAML_PROTOCOL_BINDING_HTTP_POST,
+}
+
+type OrganizationSSO struct {
+	ID            types.String            `tfsdk:"id"`
+	AutoAddMember types.Bool              `tfsdk:"auto_add_member"`
+	OIDC          *OrganizationOIDC       `tfsdk:"oidc"`
+	SAML          *OrganizationSAML       `tfsdk:"saml"`
+	RoleMappings  map[string]types.String `tfsdk:"role_mappings"`
+	TeamMappings  map[string]types.String `tfsdk:"team_mappings"`
+}
+
+type OrganizationOIDC struct {
+	Issuer                types.String   `tfsdk:"issuer"`
+	DiscoveryURL          types.String   `tfsdk:"discovery_url"`
+	ClientID              types.String   `tfsdk:"client_id"`
+	ClientSecret          types.String   `tfsdk:"client_secret"`
+	AuthorizationEndpoint types.String   `tfsdk:"authorization_endpoint"`
+	TokenEndpoint         types.String   `tfsdk:"token_endpoint"`
+	JwksURI               types.String   `tfsdk:"jwks_uri"`
+	Domain                types.String   `tfsdk:"domain"`
+	DomainAliases         []types.String `tfsdk:"domain_aliases"`
+	GroupsScopeEnabled    types.Bool     `tfsdk:"groups_scope_enabled"`
+}
+
+type OrganizationSAML struct {
+	Domain             types.String   `tfsdk:"domain"`
+	DomainAliases      []types.String `tfsdk:"domain_aliases"`
+	MetadataXML        types.String   `tfsdk:"metadata_xml"`
+	SignInEndpoint     types.String   `tfsdk:"sign_in_endpoint"`
+	SignOutEndpoint    types.String   `tfsdk:"sign_out_endpoint"`
+	DisableSignOut     types.Bool     `tfsdk:"disable_sign_out"`
+	SigningCert        types.String   `tfsdk:"signing_cert"`
+	SignRequest        types.Bool     `tfsdk:"sign_request"`
+	SignatureAlgorithm types.String   `tfsdk:"signature_algorithm"`
+	DigestAlgorithm    types.String   `tfsdk:"digest_algorithm"`
+	ProtocolBinding    types.String   `tfsdk:"protocol_binding"`
+}
+
+// ToEnsureRequest builds the request that configures SSO of the organization.
+// OIDC uses the back channel flow when a client secret is set, and the front channel flow otherwise.
+func (s *OrganizationSSO) ToEnsureRequest(orgID string) *orgcv1.EnsureSSOConfigurationRequest {
+	req := &orgcv1.EnsureSSOConfigurationRequest{
+		Id:            orgID,
+		AutoAddMember: s.AutoAddMember.ValueBool(),
+	}
+	switch {
+	case s.OIDC != nil:
+		oidc := &orgcv1.OIDCSSOOptions{
+			DiscoveryUrl:       s.OIDC.DiscoveryURL.ValueString(),
+			ClientId:           s.OIDC.ClientID.ValueString(),
+			Domain:             s.OIDC.Domain.ValueString(),
+			DomainAliases:      toStringArrayAPIModel(s.OIDC.DomainAliases),
+			GroupsScopeEnabled: s.OIDC.GroupsScopeEnabled.ValueBool(),
+		}
+		if s.OIDC.ClientSecret.ValueString() != "" {
+			oidc.Channel = &orgcv1.OIDCSSOOptions_Back{Back: &orgcv1.OIDCSSOBackChannel{
+				ClientSecret:          s.OIDC.ClientSecret.ValueString(),
+				Issuer:                s.OIDC.Issuer.ValueString(),
+				AuthorizationEndpoint: s.OIDC.AuthorizationEndpoint.ValueString(),
+				TokenEndpoint:         s.OIDC.TokenEndpoint.ValueString(),
+				JwksUri:               s.OIDC.JwksURI.ValueString(),
+			}}
+		} else {
+			oidc.Channel = &orgcv1.OIDCSSOOptions_Front{Front: &orgcv1.OIDCSSOFrontChannel{
+				Issuer:                s.OIDC.Issuer.ValueString(),
+				AuthorizationEndpoint: s.OIDC.AuthorizationEndpoint.ValueString(),
+				JwksUri:               s.OIDC.JwksURI.ValueString(),
+			}}
+		}
+		req.Options = &orgcv1.EnsureSSOConfigurationRequest_Oidc{Oidc: oidc}
+	case s.SAML != nil:
+		saml := &orgcv1.SAMLSSOOptions{
+			Domain:        s.SAML.Domain.ValueString(),
+			DomainAliases: toStringArrayAPIModel(s.SAML.DomainAliases),
+		}
+		if s.SAML.MetadataXML.ValueString() != "" {
+			saml.Options = &orgcv1.SAMLSSOOptions_MetadataXml{MetadataXml: s.SAML.MetadataXML.ValueString()}
+		} else {
+			details := &orgcv1.SAMLSSOConnectionDetails{
+				SignInEndpoint:           s.SAML.SignInEndpoint.ValueString(),
+				DisableSignOut:           s.SAML.DisableSignOut.ValueBool(),
+				Base64EncodedSigningCert: s.SAML.SigningCert.ValueString(),
+				SignRequest:              s.SAML.SignRequest.ValueBool(),
+				SignatureAlgorithm:       samlSignatureAlgorithms[s.SAML.SignatureAlgorithm.ValueString()],
+				DigestAlgorithm:          samlDigestAlgorithms[s.SAML.DigestAlgorithm.ValueString()],
+				ProtocolBinding:          samlProtocolBindings[s.SAML.ProtocolBinding.ValueString()],
+			}
+			if s.SAML.SignOutEndpoint.ValueString() != "" {
+				details.SignOutEndpoint = s.SAML.SignOutEndpoint.ValueStringPointer()
+			}
+			saml.Options = &orgcv1.SAMLSSOOptions_ConnectionDetails{ConnectionDetails: details}
+		}
+		req.Options = &orgcv1.EnsureSSOConfigurationRequest_Saml{Saml: saml}
+	}
+	return req
+}
+
+// Update sets the state from the SSO configuration. Client secrets are never returned by the API, the current ones are kept.
+func (s *OrganizationSSO) Update(orgID string, sso *orgcv1.GetSSOConfigurationResponse) {
+	s.ID = types.StringValue(orgID)
+	s.AutoAddMember = types.BoolValue(sso.GetAutoAddMember())
+	if oidc := sso.GetOidc(); oidc != nil {
+		current := s.OIDC
+		s.OIDC = &OrganizationOIDC{
+			DiscoveryURL:          types.StringValue(oidc.GetDiscoveryUrl()),
+			ClientID:              types.StringValue(oidc.GetClientId()),
+			ClientSecret:          types.StringNull(),
+			Issuer:                types.StringValue(oidc.GetFront().GetIssuer()),
+			AuthorizationEndpoint: types.StringValue(oidc.GetFront().GetAuthorizationEndpoint()),
+			TokenEndpoint:         types.StringValue(""),
+			JwksURI:               types.StringValue(oidc.GetFront().GetJwksUri()),
+			Domain:                types.StringValue(oidc.GetDomain()),
+			DomainAliases:         toStringArrayTFModel(oidc.GetDomainAliases()),
+			GroupsScopeEnabled:    types.BoolValue(oidc.GetGroupsScopeEnabled()),
+		}
+		if back := oidc.GetBack(); back != nil {
+			s.OIDC.Issuer = types.StringValue(back.GetIssuer())
+			s.OIDC.AuthorizationEndpoint = types.StringValue(back.GetAuthorizationEndpoint())
+			s.OIDC.TokenEndpoint = types.StringValue(back.GetTokenEndpoint())
+			s.OIDC.JwksURI = types.StringValue(back.GetJwksUri())
+		}
+		if current != nil {
+			s.OIDC.ClientSecret = current.ClientSecret
+		}
+	} else {
+		s.OIDC = nil
+	}
+	if saml := sso.GetSaml(); saml != nil {
+		current := s.SAML
+		details := saml.GetConnectionDetails()
+		s.SAML = &OrganizationSAML{
+			Domain:             types.StringValue(saml.GetDomain()),
+			DomainAliases:      toStringArrayTFModel(saml.GetDomainAliases()),
+			MetadataXML:        types.StringNull(),
+			SignInEndpoint:     types.StringValue(details.GetSignInEndpoint()),
+			SignOutEndpoint:    types.StringValue(details.GetSignOutEndpoint()),
+			DisableSignOut:     types.BoolValue(details.GetDisableSignOut()),
+			SigningCert:        types.StringValue(details.GetBase64EncodedSigningCert()),
+			SignRequest:        types.BoolValue(details.GetSignRequest()),
+			SignatureAlgorithm: types.StringValue(samlSignatureAlgorithmName(details.GetSignatureAlgorithm())),
+			DigestAlgorithm:    types.StringValue(samlDigestAlgorithmName(details.GetDigestAlgorithm())),
+			ProtocolBinding:    types.StringValue(samlProtocolBindingName(details.GetProtocolBinding())),
+		}
+		if saml.GetMetadataXml() != "" {
+			s.SAML.MetadataXML = types.StringValue(saml.GetMetadataXml())
+		}
+		// The metadata may be returned as the connection details it describes, keep the configured one.
+		if current != nil && !current.MetadataXML.IsNull() {
+			s.SAML.MetadataXML = current.MetadataXML
+		}
+	} else {
+		s.SAML = nil
+	}
+}
+
+// UpdateRoleMappings sets the group to role mappings from the organization OIDC map.
+func (s *OrganizationSSO) UpdateRoleMappings(entries map[string]string) {
+	s.RoleMappings = toStringMapTFModel(entries)
+}
+
+// UpdateTeamMappings sets the group to team mappings from the team OIDC map.
+func (s *OrganizationSSO) UpdateTeamMappings(entries map[string]string) {
+	s.TeamMappings = toStringMapTFModel(entries)
+}
+
+// ToStringMapAPIModel converts a map attribute of strings to the API model.
+func ToStringMapAPIModel(m map[string]types.String) map[string]string {
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = v.ValueString()
+	}
+	return res
+}
+
+func toStringMapTFModel(m map[string]string) map[string]types.String {
+	res := make(map[string]types.String, len(m))
+	for k, v := range m {
+		res[k] = types.StringValue(v)
+	}
+	return res
+}
+
+func samlSignatureAlgorithmName(algorithm orgcv1.SAMLSignatureAlgorithm) string {
+	for name, a := range samlSignatureAlgorithms {
+		if a == algorithm {
+			return name
+		}
+	}
+	return ""
+}
+
+func samlDigestAlgorithmName(algorithm orgcv1.SAMLDigestAlgorithm) string {
+	for name, a := range samlDigestAlgorithms {
+		if a == algorithm {
+			return name
+		}
+	}
+	return ""
+}
+
+func samlProtocolBindingName(binding orgcv1.SAMLProtocolBinding) string {
+	for name, b := range samlProtocolBindings {
+		if b == binding {
+			return name
+		}
+	}
+	return ""
+}
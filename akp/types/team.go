@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+)
+
+type Team struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+}
+
+type TeamMember struct {
+	ID     types.String `tfsdk:"id"`
+	Team   types.String `tfsdk:"team"`
+	Email  types.String `tfsdk:"email"`
+	UserID types.String `tfsdk:"user_id"`
+}
+
+// Update sets the state from the team. Teams are identified by name.
+func (t *Team) Update(team *orgcv1.Team) {
+	t.ID = types.StringValue(team.GetName())
+	t.Name = types.StringValue(team.GetName())
+	t.Description = types.StringValue(team.GetDescription())
+	t.MemberCount = types.Int64Value(team.GetMemberCount())
+}
+
+// Update sets the state from the member of the given team. Team members are identified by team name and email.
+// Emails are case-insensitive, the current email is kept when it only differs by case.
+func (m *TeamMember) Update(teamName string, member *orgcv1.TeamMember) {
+	if !strings.EqualFold(m.Email.ValueString(), member.GetEmail()) {
+		m.Email = types.StringValue(member.GetEmail())
+	}
+	m.ID = types.StringValue(teamName + "/" + m.Email.ValueString())
+	m.Team = types.StringValue(teamName)
+	m.UserID = types.StringValue(member.GetId())
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Timeouts struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+	Read   types.String `tfsdk:"read"`
+}
+
+// CreateTimeout returns the configured create timeout, or def if none is set.
+func (t *Timeouts) CreateTimeout(def time.Duration) time.Duration {
+	if t == nil {
+		return def
+	}
+	return parseTimeout(t.Create, def)
+}
+
+// UpdateTimeout returns the configured update timeout, or def if none is set.
+func (t *Timeouts) UpdateTimeout(def time.Duration) time.Duration {
+	if t == nil {
+		return def
+	}
+	return parseTimeout(t.Update, def)
+}
+
+// DeleteTimeout returns the configured delete timeout, or def if none is set.
+func (t *Timeouts) DeleteTimeout(def time.Duration) time.Duration {
+	if t == nil {
+		return def
+	}
+	return parseTimeout(t.Delete, def)
+}
+
+// ReadTimeout returns the configured read timeout, or def if none is set.
+func (t *Timeouts) ReadTimeout(def time.Duration) time.Duration {
+	if t == nil {
+		return def
+	}
+	return parseTimeout(t.Read, def)
+}
+
+func parseTimeout(v types.String, def time.Duration) time.Duration {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		// The schema validates the duration format, so this should never happen.
+		return def
+	}
+	return d
+}
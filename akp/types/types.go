@@ -152,7 +152,9 @@ func toBoolPointer(b tftypes.Bool) *bool {
 	return b.ValueBoolPointer()
 }
 
-func (c *Cluster) Update(ctx context.Context, diagnostics *diag.Diagnostics, apiCluster *argocdv1.Cluster, plan *Cluster) {
+// Update sets the cluster from the API cluster. When imported is set, the custom size and the autoscaler config are
+// derived from the API cluster rather than kept from the plan, as there is no configuration to take them from yet.
+func (c *Cluster) Update(ctx context.Context, diagnostics *diag.Diagnostics, apiCluster *argocdv1.Cluster, plan *Cluster, imported bool) {
 	c.ID = tftypes.StringValue(apiCluster.GetId())
 	c.Name = tftypes.StringValue(apiCluster.GetName())
 	c.Namespace = tftypes.StringValue(apiCluster.GetNamespace())
@@ -198,20 +200,19 @@ func (c *Cluster) Update(ctx context.Context, diagnostics *diag.Diagnostics, api
 	var existingConfig kustomizetypes.Kustomization
 	size := tftypes.StringValue(ClusterSizeString[apiCluster.GetData().GetSize()])
 	var customConfig *CustomAgentSizeConfig
-	if err := yaml.Unmarshal(yamlData, &existingConfig); err == nil && plan != nil && plan.Spec != nil && plan.Spec.Data.CustomAgentSizeConfig != nil {
+	// On import, custom sizes are derived from the size patches that turn a large agent into a custom one.
+	customPlanned := !imported && plan != nil && plan.Spec != nil && plan.Spec.Data.CustomAgentSizeConfig != nil
+	customImported := imported && apiCluster.GetData().GetSize() == argocdv1.ClusterSize_CLUSTER_SIZE_LARGE
+	if err := yaml.Unmarshal(yamlData, &existingConfig); err == nil && (customPlanned || customImported) {
 		extractedCustomConfig := extractCustomSizeConfig(existingConfig)
 		if extractedCustomConfig != nil {
-			if plan != nil && plan.Spec != nil && plan.Spec.Data.CustomAgentSizeConfig != nil {
-				if areCustomAgentConfigsEquivalent(plan.Spec.Data.CustomAgentSizeConfig, extractedCustomConfig) {
-					customConfig = plan.Spec.Data.CustomAgentSizeConfig
-				} else {
-					customConfig = plan.Spec.Data.CustomAgentSizeConfig
-				}
-				existingConfig.Patches = filterNonSizePatchesKustomize(existingConfig.Patches)
-				existingConfig.Replicas = filterNonRepoServerReplicasKustomize(existingConfig.Replicas)
+			if customPlanned {
+				customConfig = plan.Spec.Data.CustomAgentSizeConfig
 			} else {
 				customConfig = extractedCustomConfig
 			}
+			existingConfig.Patches = filterNonSizePatchesKustomize(existingConfig.Patches)
+			existingConfig.Replicas = filterNonRepoServerReplicasKustomize(existingConfig.Replicas)
 
 			if existingConfig.CheckEmpty() != nil {
 				kustomization = tftypes.StringValue("{}\n")
@@ -231,7 +232,9 @@ func (c *Cluster) Update(ctx context.Context, diagnostics *diag.Diagnostics, api
 	c.Annotations = annotations
 
 	autoscalerConfig := toAutoScalerConfigTFModel(nil)
-	if plan != nil && plan.Spec != nil && plan.Spec.Data.Size.ValueString() == "auto" {
+	if imported && size.ValueString() == "auto" {
+		autoscalerConfig = toAutoScalerConfigTFModel(apiCluster.GetData().GetAutoscalerConfig())
+	} else if plan != nil && plan.Spec != nil && plan.Spec.Data.Size.ValueString() == "auto" {
 		newAPIConfig := apiCluster.GetData().GetAutoscalerConfig()
 		if !plan.Spec.Data.AutoscalerConfig.IsNull() && !plan.Spec.Data.AutoscalerConfig.IsUnknown() && newAPIConfig != nil &&
 			newAPIConfig.RepoServer != nil && newAPIConfig.ApplicationController != nil {
@@ -1446,39 +1449,6 @@ func extractCustomSizeConfig(existingConfig kustomizetypes.Kustomization) *Custo
 	}
 }
 
-func areCustomAgentConfigsEquivalent(config1, config2 *CustomAgentSizeConfig) bool {
-	if config1 == nil || config2 == nil {
-		return config1 == config2
-	}
-	if config1.ApplicationController != nil && config2.ApplicationController != nil {
-		if !areResourcesEquivalent(
-			config1.ApplicationController.Cpu.ValueString(),
-			config2.ApplicationController.Cpu.ValueString(),
-		) || !areResourcesEquivalent(
-			config1.ApplicationController.Memory.ValueString(),
-			config2.ApplicationController.Memory.ValueString(),
-		) {
-			return false
-		}
-	} else if config1.ApplicationController != nil || config2.ApplicationController != nil {
-		return false
-	}
-	if config1.RepoServer != nil && config2.RepoServer != nil {
-		if !areResourcesEquivalent(
-			config1.RepoServer.Cpu.ValueString(),
-			config2.RepoServer.Cpu.ValueString(),
-		) || !areResourcesEquivalent(
-			config1.RepoServer.Memory.ValueString(),
-			config2.RepoServer.Memory.ValueString(),
-		) || config1.RepoServer.Replicas != config2.RepoServer.Replicas {
-			return false
-		}
-	} else if config1.RepoServer != nil || config2.RepoServer != nil {
-		return false
-	}
-	return true
-}
-
 func areAutoScalerConfigsEquivalent(plan, now *AutoScalerConfig) bool {
 	if plan == nil {
 		return true
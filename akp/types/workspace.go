@@ -0,0 +1,90 @@
+package types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+)
+
+const (
+	WorkspaceRoleMember = "member"
+	WorkspaceRoleAdmin  = "admin"
+)
+
+var workspaceRoles = map[string]orgcv1.WorkspaceMemberRole{
+	WorkspaceRoleMember: orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER,
+	WorkspaceRoleAdmin:  orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_ADMIN,
+}
+
+type Workspace struct {
+	ID          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	IsDefault   types.Bool        `tfsdk:"is_default"`
+	Members     []WorkspaceMember `tfsdk:"members"`
+}
+
+type WorkspaceMember struct {
+	UserEmail types.String `tfsdk:"user_email"`
+	TeamName  types.String `tfsdk:"team_name"`
+	Role      types.String `tfsdk:"role"`
+}
+
+type Workspaces struct {
+	ID         types.String `tfsdk:"id"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Workspaces []Workspace  `tfsdk:"workspaces"`
+}
+
+func (w *Workspace) Update(workspace *orgcv1.Workspace) {
+	w.ID = types.StringValue(workspace.GetId())
+	w.Name = types.StringValue(workspace.GetName())
+	w.Description = types.StringValue(workspace.GetDescription())
+	w.IsDefault = types.BoolValue(workspace.GetIsDefault())
+}
+
+// UpdateMembers sets the members of the workspace from the API members. Members that are neither users nor teams are skipped.
+func (w *Workspace) UpdateMembers(members []*orgcv1.WorkspaceMember) {
+	w.Members = []WorkspaceMember{}
+	for _, m := range members {
+		member := WorkspaceMember{
+			UserEmail: types.StringNull(),
+			TeamName:  types.StringNull(),
+			Role:      types.StringValue(workspaceRoleName(m.GetRole())),
+		}
+		switch {
+		case m.GetUser() != nil:
+			member.UserEmail = types.StringValue(m.GetUser().GetEmail())
+		case m.GetTeam() != nil:
+			member.TeamName = types.StringValue(m.GetTeam().GetName())
+		default:
+			continue
+		}
+		w.Members = append(w.Members, member)
+	}
+}
+
+func (w *Workspace) ToMemberRefs() []*orgcv1.WorkspaceMemberRef {
+	refs := make([]*orgcv1.WorkspaceMemberRef, 0, len(w.Members))
+	for _, m := range w.Members {
+		ref := &orgcv1.WorkspaceMemberRef{
+			Role: workspaceRoles[m.Role.ValueString()],
+		}
+		if !m.UserEmail.IsNull() {
+			ref.Member = &orgcv1.WorkspaceMemberRef_UserEmail{UserEmail: m.UserEmail.ValueString()}
+		} else {
+			ref.Member = &orgcv1.WorkspaceMemberRef_TeamName{TeamName: m.TeamName.ValueString()}
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+func workspaceRoleName(role orgcv1.WorkspaceMemberRole) string {
+	for name, r := range workspaceRoles {
+		if r == role {
+			return name
+		}
+	}
+	return role.String()
+}
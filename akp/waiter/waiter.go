@@ -0,0 +1,136 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// Config configures how Poll waits between attempts.
+type Config struct {
+	// InitialInterval is the wait between the first and second attempt.
+	InitialInterval time.Duration
+	// MaxInterval caps the wait between two attempts.
+	MaxInterval time.Duration
+	// Multiplier is applied to the interval after every attempt.
+	Multiplier float64
+	// Jitter randomizes every interval by up to +/- the given fraction (0 to 1).
+	Jitter float64
+	// Timeout is an optional deadline for the whole wait, on top of any deadline set on the context.
+	Timeout time.Duration
+}
+
+// DefaultConfig is used for every wait unless the provider configuration overrides it.
+var DefaultConfig = Config{
+	InitialInterval: 1 * time.Second,
+	MaxInterval:     30 * time.Second,
+	Multiplier:      1.5,
+	Jitter:          0.2,
+}
+
+// ConditionFunc reports whether the wait is over. Returning an error stops polling immediately.
+type ConditionFunc func(ctx context.Context) (done bool, err error)
+
+// Poll calls condition until it reports done, returns an error, or the context (or Config.Timeout) expires.
+// The condition is called once right away, then with an exponentially growing, jittered interval.
+// When the wait expires, the returned error wraps the context error so IsTimeout can detect it.
+func Poll(ctx context.Context, cfg Config, condition ConditionFunc) error {
+	cfg = cfg.withDefaults()
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	interval := cfg.InitialInterval
+	for {
+		done, err := condition(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(cfg.jitter(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		interval = cfg.next(interval)
+	}
+}
+
+// IsTimeout reports whether err was returned by Poll because the wait expired or was cancelled.
+func IsTimeout(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
+}
+
+func (c Config) withDefaults() Config {
+	if c.InitialInterval <= 0 {
+		c.InitialInterval = DefaultConfig.InitialInterval
+	}
+	if c.MaxInterval <= 0 {
+		c.MaxInterval = DefaultConfig.MaxInterval
+	}
+	if c.MaxInterval < c.InitialInterval {
+		c.MaxInterval = c.InitialInterval
+	}
+	if c.Multiplier < 1 {
+		c.Multiplier = DefaultConfig.Multiplier
+	}
+	if c.Jitter < 0 {
+		c.Jitter = 0
+	}
+	if c.Jitter > 1 {
+		c.Jitter = 1
+	}
+	return c
+}
+
+func (c Config) next(interval time.Duration) time.Duration {
+	next := time.Duration(float64(interval) * c.Multiplier)
+	if next > c.MaxInterval {
+		return c.MaxInterval
+	}
+	return next
+}
+
+func (c Config) jitter(interval time.Duration) time.Duration {
+	if c.Jitter == 0 {
+		return interval
+	}
+	delta := c.Jitter * float64(interval)
+	return time.Duration(float64(interval) - delta + rand.Float64()*2*delta)
+}
+
+// RetryableFunc reports whether a failed attempt may be retried.
+type RetryableFunc func(err error) bool
+
+// Retry calls fn until it succeeds, fails with an error retryable rejects, or maxRetries retries have been made.
+// Attempts are spaced the same way as in Poll. The last error is returned when retries are exhausted.
+func Retry(ctx context.Context, cfg Config, maxRetries int, retryable RetryableFunc, fn func(ctx context.Context) error) error {
+	cfg = cfg.withDefaults()
+	interval := cfg.InitialInterval
+	for attempt := 0; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= maxRetries || !retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(cfg.jitter(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		interval = cfg.next(interval)
+	}
+}
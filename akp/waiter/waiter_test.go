@@ -0,0 +1,172 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testConfig = Config{
+	InitialInterval: 1 * time.Millisecond,
+	MaxInterval:     5 * time.Millisecond,
+	Multiplier:      2,
+	Jitter:          0.5,
+}
+
+func TestPoll(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       Config
+		condition func(calls int) (bool, error)
+		wantCalls int
+		wantErr   error
+		isTimeout bool
+	}{
+		{
+			name: "done on first attempt",
+			cfg:  testConfig,
+			condition: func(calls int) (bool, error) {
+				return true, nil
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "done after a few attempts",
+			cfg:  testConfig,
+			condition: func(calls int) (bool, error) {
+				return calls == 4, nil
+			},
+			wantCalls: 4,
+		},
+		{
+			name: "condition error stops polling",
+			cfg:  testConfig,
+			condition: func(calls int) (bool, error) {
+				if calls == 2 {
+					return false, errors.New("some error")
+				}
+				return false, nil
+			},
+			wantCalls: 2,
+			wantErr:   errors.New("some error"),
+		},
+		{
+			name: "deadline",
+			cfg: Config{
+				InitialInterval: 1 * time.Millisecond,
+				Timeout:         20 * time.Millisecond,
+			},
+			condition: func(calls int) (bool, error) {
+				return false, nil
+			},
+			isTimeout: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := Poll(context.Background(), tt.cfg, func(ctx context.Context) (bool, error) {
+				calls++
+				return tt.condition(calls)
+			})
+			if tt.isTimeout {
+				assert.True(t, IsTimeout(err), "expected timeout, got %v", err)
+				return
+			}
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestPoll_cancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Poll(ctx, Config{InitialInterval: time.Hour}, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestConfig_next(t *testing.T) {
+	cfg := Config{InitialInterval: time.Second, MaxInterval: 5 * time.Second, Multiplier: 2}.withDefaults()
+	interval := cfg.InitialInterval
+	var got []time.Duration
+	for i := 0; i < 5; i++ {
+		got = append(got, interval)
+		interval = cfg.next(interval)
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, got)
+}
+
+func TestConfig_jitter(t *testing.T) {
+	cfg := Config{Jitter: 0.2}
+	for i := 0; i < 100; i++ {
+		d := cfg.jitter(10 * time.Second)
+		assert.GreaterOrEqual(t, d, 8*time.Second)
+		assert.LessOrEqual(t, d, 12*time.Second)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errTransient := errors.New("transient")
+	errPermanent := errors.New("permanent")
+	retryable := func(err error) bool { return errors.Is(err, errTransient) }
+
+	tests := []struct {
+		name       string
+		maxRetries int
+		errs       []error
+		wantCalls  int
+		wantErr    error
+	}{
+		{
+			name:       "success",
+			maxRetries: 3,
+			errs:       []error{nil},
+			wantCalls:  1,
+		},
+		{
+			name:       "success after transient errors",
+			maxRetries: 3,
+			errs:       []error{errTransient, errTransient, nil},
+			wantCalls:  3,
+		},
+		{
+			name:       "retries exhausted",
+			maxRetries: 2,
+			errs:       []error{errTransient, errTransient, errTransient, nil},
+			wantCalls:  3,
+			wantErr:    errTransient,
+		},
+		{
+			name:       "permanent error",
+			maxRetries: 3,
+			errs:       []error{errTransient, errPermanent, nil},
+			wantCalls:  2,
+			wantErr:    errPermanent,
+		},
+		{
+			name:       "retries disabled",
+			maxRetries: 0,
+			errs:       []error{errTransient, nil},
+			wantCalls:  1,
+			wantErr:    errTransient,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := Retry(context.Background(), testConfig, tt.maxRetries, retryable, func(ctx context.Context) error {
+				err := tt.errs[calls]
+				calls++
+				return err
+			})
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package akp
+
+import (
+	"context"
+	"fmt"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pkg/errors"
+
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+)
+
+// getWorkspace looks up a workspace of the organization by ID or name. The default workspace is returned when nameOrID is empty.
+func getWorkspace(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, orgID, nameOrID string) (*orgcv1.Workspace, error) {
+	workspaces, err := orgc.ListWorkspaces(ctx, &orgcv1.ListWorkspacesRequest{
+		OrganizationId: orgID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read workspaces")
+	}
+	for _, w := range workspaces.GetWorkspaces() {
+		if nameOrID == "" && w.GetIsDefault() {
+			return w, nil
+		}
+		if nameOrID != "" && (w.GetId() == nameOrID || w.GetName() == nameOrID) {
+			return w, nil
+		}
+	}
+	if nameOrID == "" {
+		return nil, errors.New("Default workspace not found")
+	}
+	return nil, fmt.Errorf("Workspace %q not found", nameOrID)
+}
+
+// getKargoInstanceWorkspace returns the workspace the given Kargo instance belongs to.
+func getKargoInstanceWorkspace(ctx context.Context, kargoc kargov1.KargoServiceGatewayClient, orgc orgcv1.OrganizationServiceGatewayClient, orgID, instanceID string) (*orgcv1.Workspace, error) {
+	instances, err := kargoc.ListKargoInstances(ctx, &kargov1.ListKargoInstancesRequest{
+		OrganizationId: orgID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read Kargo instances")
+	}
+	for _, instance := range instances.GetInstances() {
+		if instance.GetId() == instanceID {
+			return getWorkspace(ctx, orgc, orgID, instance.GetWorkspaceId())
+		}
+	}
+	return nil, fmt.Errorf("Kargo instance %q not found", instanceID)
+}
+
+// isWorkspace reports whether nameOrID refers to ws, either by name or by ID.
+func isWorkspace(ws *orgcv1.Workspace, nameOrID string) bool {
+	return nameOrID == ws.GetId() || nameOrID == ws.GetName()
+}
+
+// workspaceValue returns the state value of the workspace attribute for ws.
+// The current value is kept when it already refers to ws, either by name or by ID, to avoid spurious diffs.
+func workspaceValue(ws *orgcv1.Workspace, current tftypes.String) tftypes.String {
+	if !current.IsNull() && !current.IsUnknown() && isWorkspace(ws, current.ValueString()) {
+		return current
+	}
+	return tftypes.StringValue(ws.GetName())
+}
+
+// workspaceMembersPageSize is the number of workspace members requested per page.
+const workspaceMembersPageSize = 100
+
+// listWorkspaceMembers returns all members of the workspace, reading every page.
+func listWorkspaceMembers(ctx context.Context, orgc orgcv1.OrganizationServiceGatewayClient, orgID, workspaceID string) ([]*orgcv1.WorkspaceMember, error) {
+	var members []*orgcv1.WorkspaceMember
+	limit := uint32(workspaceMembersPageSize)
+	for offset := uint32(0); ; offset += limit {
+		resp, err := orgc.ListWorkspaceMembers(ctx, &orgcv1.ListWorkspaceMembersRequest{
+			OrganizationId: orgID,
+			WorkspaceId:    workspaceID,
+			Limit:          &limit,
+			Offset:         &offset,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to read workspace members")
+		}
+		members = append(members, resp.GetWorkspaceMembers()...)
+		if len(resp.GetWorkspaceMembers()) < int(limit) {
+			return members, nil
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package akp
+
+import (
+	"context"
+	"testing"
+
+	hashitype "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+)
+
+type fakeOrgClient struct {
+	orgcv1.OrganizationServiceGatewayClient
+	workspaces           []*orgcv1.Workspace
+	members              []*orgcv1.OrganizationMember
+	invitees             []*orgcv1.OrganizationInvitee
+	listWorkspaceMembers func(context.Context, *orgcv1.ListWorkspaceMembersRequest) (*orgcv1.ListWorkspaceMembersResponse, error)
+}
+
+func (c *fakeOrgClient) ListWorkspaces(ctx context.Context, req *orgcv1.ListWorkspacesRequest) (*orgcv1.ListWorkspacesResponse, error) {
+	return &orgcv1.ListWorkspacesResponse{Workspaces: c.workspaces}, nil
+}
+
+func (c *fakeOrgClient) ListOrganizationMembers(ctx context.Context, req *orgcv1.ListOrganizationMembersRequest) (*orgcv1.ListOrganizationMembersResponse, error) {
+	return &orgcv1.ListOrganizationMembersResponse{Members: c.members}, nil
+}
+
+func (c *fakeOrgClient) ListOrganizationInvitees(ctx context.Context, req *orgcv1.ListOrganizationInviteesRequest) (*orgcv1.ListOrganizationInviteesResponse, error) {
+	return &orgcv1.ListOrganizationInviteesResponse{Invitees: c.invitees}, nil
+}
+
+func (c *fakeOrgClient) ListWorkspaceMembers(ctx context.Context, req *orgcv1.ListWorkspaceMembersRequest) (*orgcv1.ListWorkspaceMembersResponse, error) {
+	return c.listWorkspaceMembers(ctx, req)
+}
+
+func TestGetWorkspace(t *testing.T) {
+	client := &fakeOrgClient{workspaces: []*orgcv1.Workspace{
+		{Id: "ws-1", Name: "default", IsDefault: true},
+		{Id: "ws-2", Name: "team-a"},
+	}}
+	tests := []struct {
+		name     string
+		nameOrID string
+		wantID   string
+		wantErr  string
+	}{
+		{name: "default", nameOrID: "", wantID: "ws-1"},
+		{name: "by name", nameOrID: "team-a", wantID: "ws-2"},
+		{name: "by id", nameOrID: "ws-2", wantID: "ws-2"},
+		{name: "not found", nameOrID: "team-b", wantErr: `Workspace "team-b" not found`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws, err := getWorkspace(context.Background(), client, "org", tt.nameOrID)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, ws.GetId())
+		})
+	}
+}
+
+func TestIsWorkspace(t *testing.T) {
+	ws := &orgcv1.Workspace{Id: "ws-2", Name: "team-a"}
+	assert.True(t, isWorkspace(ws, "ws-2"))
+	assert.True(t, isWorkspace(ws, "team-a"))
+	assert.False(t, isWorkspace(ws, "team-b"))
+	assert.False(t, isWorkspace(ws, ""))
+}
+
+func TestWorkspaceValue(t *testing.T) {
+	ws := &orgcv1.Workspace{Id: "ws-2", Name: "team-a"}
+	assert.Equal(t, hashitype.StringValue("ws-2"), workspaceValue(ws, hashitype.StringValue("ws-2")))
+	assert.Equal(t, hashitype.StringValue("team-a"), workspaceValue(ws, hashitype.StringValue("team-a")))
+	assert.Equal(t, hashitype.StringValue("team-a"), workspaceValue(ws, hashitype.StringValue("team-b")))
+	assert.Equal(t, hashitype.StringValue("team-a"), workspaceValue(ws, hashitype.StringNull()))
+	assert.Equal(t, hashitype.StringValue("team-a"), workspaceValue(ws, hashitype.StringUnknown()))
+}
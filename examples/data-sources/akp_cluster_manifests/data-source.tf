@@ -0,0 +1,13 @@
+data "akp_cluster_manifests" "example" {
+  instance_id = akp_cluster.example.instance_id
+  name        = akp_cluster.example.name
+}
+
+# Commit the agent manifests to a GitOps repository, one file per Kubernetes object.
+resource "github_repository_file" "agent" {
+  count               = length(data.akp_cluster_manifests.example.documents)
+  repository          = "gitops"
+  file                = "clusters/example/agent/${count.index}.yaml"
+  content             = data.akp_cluster_manifests.example.documents[count.index]
+  overwrite_on_create = true
+}
@@ -0,0 +1,3 @@
+data "akp_instances" "example" {
+  name_regex = "^prod-"
+}
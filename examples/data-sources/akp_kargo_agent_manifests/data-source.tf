@@ -0,0 +1,12 @@
+data "akp_kargo_agent_manifests" "example" {
+  instance_id = akp_kargo_agent.example.instance_id
+  name        = akp_kargo_agent.example.name
+}
+
+# Commit the agent manifests to a GitOps repository as a single file.
+resource "github_repository_file" "agent" {
+  repository          = "gitops"
+  file                = "clusters/example/kargo-agent.yaml"
+  content             = data.akp_kargo_agent_manifests.example.manifests
+  overwrite_on_create = true
+}
@@ -0,0 +1,4 @@
+data "akp_kargo_instances" "example" {
+  name_regex = "^prod-"
+  workspace  = "default"
+}
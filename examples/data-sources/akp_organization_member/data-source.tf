@@ -0,0 +1,3 @@
+data "akp_organization_member" "example" {
+  email = "alice@example.com"
+}
@@ -0,0 +1,4 @@
+data "akp_team_member" "example" {
+  team  = "platform"
+  email = "alice@example.com"
+}
@@ -0,0 +1,11 @@
+ephemeral "akp_cluster_manifests" "example" {
+  instance_id = akp_cluster.example.instance_id
+  name        = akp_cluster.example.name
+}
+
+# Ephemeral values can only be passed to ephemeral contexts, e.g. write-only attributes, provider
+# configurations or module variables declared with `ephemeral = true`.
+module "agent" {
+  source    = "./modules/agent"
+  manifests = ephemeral.akp_cluster_manifests.example.documents
+}
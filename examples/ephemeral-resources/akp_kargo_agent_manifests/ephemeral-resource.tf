@@ -0,0 +1,11 @@
+ephemeral "akp_kargo_agent_manifests" "example" {
+  instance_id = akp_kargo_agent.example.instance_id
+  name        = akp_kargo_agent.example.name
+}
+
+# Ephemeral values can only be passed to ephemeral contexts, e.g. write-only attributes, provider
+# configurations or module variables declared with `ephemeral = true`.
+module "kargo_agent" {
+  source    = "./modules/kargo-agent"
+  manifests = ephemeral.akp_kargo_agent_manifests.example.documents
+}
@@ -1,3 +1,9 @@
 provider "akp" {
   org_name = "organization-name"
+
+  # Used to remove the agents of destroyed clusters and Kargo agents, the
+  # kube_config of the resources is write-only and not stored in the state.
+  kube_config = {
+    config_path = "~/.kube/config"
+  }
 }
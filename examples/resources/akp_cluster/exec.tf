@@ -0,0 +1,26 @@
+data "aws_eks_cluster" "my-cluster" {
+  name = "my-cluster"
+}
+
+resource "akp_cluster" "my-cluster" {
+  instance_id = akp_instance.argocd.id
+  kube_config = {
+    host                   = data.aws_eks_cluster.my-cluster.endpoint
+    cluster_ca_certificate = base64decode(data.aws_eks_cluster.my-cluster.certificate_authority[0].data)
+    exec = {
+      api_version = "client.authentication.k8s.io/v1beta1"
+      command     = "aws"
+      args        = ["eks", "get-token", "--cluster-name", data.aws_eks_cluster.my-cluster.name]
+      env = {
+        AWS_REGION = "us-west-2"
+      }
+    }
+  }
+  name      = "my-cluster"
+  namespace = "akuity"
+  spec = {
+    data = {
+      size = "small"
+    }
+  }
+}
@@ -52,18 +52,17 @@ resource "akp_cluster" "example" {
     }
   }
 
+  # kube_config is write-only and not stored in the state. A token retrieved from a Terraform provider
+  # (e.g. aws_eks_cluster_auth or google_client_config) can change over time without causing a diff.
   kube_config = {
     config_path = "test.kubeconfig"
     token       = "YOUR TOKEN"
   }
 
-  # When using a Kubernetes token retrieved from a Terraform provider (e.g. aws_eks_cluster_auth or google_client_config) in the above `kube_config`,
-  # the token value may change over time. This will cause Terraform to detect a diff in the `token` on each plan and apply.
-  # To prevent constant changes, you can add the `token` field path to the `lifecycle` block's `ignore_changes` list:
-  #  https://developer.hashicorp.com/terraform/language/meta-arguments/lifecycle#ignore_changes
-  lifecycle {
-    ignore_changes = [
-      kube_config.token,
-    ]
+  # Agent installation waits until the agent reports healthy. Tune how long Terraform waits for each operation.
+  timeouts = {
+    create = "30m"
+    update = "30m"
+    delete = "15m"
   }
 }
@@ -0,0 +1,5 @@
+# Organization API keys can be imported by ID
+terraform import akp_organization_api_key.example 1a2b3c4d5e6f7g8h
+
+# Workspace API keys can be imported by workspace name or ID, and API key ID
+terraform import akp_organization_api_key.example team-a/1a2b3c4d5e6f7g8h
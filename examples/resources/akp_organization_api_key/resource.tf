@@ -0,0 +1,11 @@
+resource "time_rotating" "ci" {
+  rotation_days = 30
+}
+
+resource "akp_organization_api_key" "ci" {
+  description      = "CI pipeline"
+  role             = "workspace/member"
+  workspace        = "team-a"
+  expiry           = "2160h"
+  rotation_trigger = time_rotating.ci.id
+}
@@ -0,0 +1,2 @@
+# Organization members and pending invitations can be imported by email
+terraform import akp_organization_member.example alice@example.com
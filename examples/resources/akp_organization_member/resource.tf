@@ -0,0 +1,4 @@
+resource "akp_organization_member" "alice" {
+  email = "alice@example.com"
+  role  = "member"
+}
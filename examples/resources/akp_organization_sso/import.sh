@@ -0,0 +1,2 @@
+# The SSO configuration of the organization the provider is configured for is imported, whatever the ID
+terraform import akp_organization_sso.example sso
@@ -0,0 +1,18 @@
+resource "akp_organization_sso" "example" {
+  auto_add_member = true
+  oidc = {
+    discovery_url        = "https://example.okta.com/.well-known/openid-configuration"
+    client_id            = "0oa1b2c3d4e5f6g7h8i9"
+    client_secret        = var.sso_client_secret
+    domain               = "example.com"
+    domain_aliases       = ["example.org"]
+    groups_scope_enabled = true
+  }
+  role_mappings = {
+    "platform-admins" = "admin"
+    "engineering"     = "member"
+  }
+  team_mappings = {
+    "platform" = akp_team.platform.name
+  }
+}
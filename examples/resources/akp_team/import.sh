@@ -0,0 +1,2 @@
+# Teams can be imported by name
+terraform import akp_team.example platform
@@ -0,0 +1,14 @@
+resource "akp_team" "platform" {
+  name        = "platform"
+  description = "Platform engineering"
+}
+
+resource "akp_workspace" "example" {
+  name = "platform"
+  members = [
+    {
+      team_name = akp_team.platform.name
+      role      = "admin"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Team members can be imported by team name and email
+terraform import akp_team_member.example platform/alice@example.com
@@ -0,0 +1,4 @@
+resource "akp_team_member" "alice" {
+  team  = akp_team.platform.name
+  email = akp_organization_member.alice.email
+}
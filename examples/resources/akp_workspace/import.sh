@@ -0,0 +1,3 @@
+# Workspaces can be imported by ID or name
+# The members are imported as well, leave `members` out of the configuration to stop managing them
+terraform import akp_workspace.example team-a
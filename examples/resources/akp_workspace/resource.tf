@@ -0,0 +1,14 @@
+resource "akp_workspace" "example" {
+  name        = "team-a"
+  description = "Workspace of team A"
+  members = [
+    {
+      user_email = "alice@example.com"
+      role       = "admin"
+    },
+    {
+      team_name = "team-a"
+      role      = "member"
+    },
+  ]
+}